@@ -49,7 +49,7 @@ func newRequiredCheck(field *Field, check *Check) (IsValid, error) {
 	case reflect.Struct:
 		_, ok := field.Type.MethodByName("IsZero")
 		if !ok {
-			return nil, fmt.Errorf("struct does not implement IsZero for required check: %v", field.Type.String())
+			return nil, NewUnsupportedTypeError(field, check)
 		}
 		return checkRequiredNoZeroStruct, nil
 	case reflect.String:
@@ -66,7 +66,7 @@ func newRequiredCheck(field *Field, check *Check) (IsValid, error) {
 	case reflect.Slice:
 		return checkRequiredSlice, nil
 	}
-	return nil, fmt.Errorf("required unsupported type: %v %v", field.Name, field.Type.String())
+	return nil, NewUnsupportedTypeError(field, check)
 }
 
 func checkRequiredNumeric(ctx context.Context, value interface{}) (bool, error) {
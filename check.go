@@ -1,7 +1,6 @@
 package govalidator
 
 import (
-	"fmt"
 	"github.com/viant/structology"
 	"github.com/viant/xunsafe"
 	"reflect"
@@ -13,14 +12,17 @@ type (
 	//FieldCheckPos represents field checks
 	FieldCheck struct {
 		*Field
-		Owner   reflect.Type
-		IsValid []IsValid
+		Owner    reflect.Type
+		IsValid  []IsValid
+		Messages []*MessageTemplate
 	}
 
 	Field struct {
 		*Tag
 		*xunsafe.Field
 		FieldCheck *FieldCheck
+		unwrapper  *unwrapper
+		owner      reflect.Type
 	}
 
 	//Checks represents struct checks
@@ -28,6 +30,7 @@ type (
 		Type           reflect.Type
 		Fields         []*FieldCheck
 		Slices         []*Field
+		Maps           []*Field
 		Structs        []*Field
 		SimpleSlices   []*Field
 		marker         *structology.Marker
@@ -54,27 +57,39 @@ func NewChecks(t reflect.Type) (*Checks, error) {
 		if structology.IsSetMarker(xField.Tag) {
 			continue
 		}
-		if isStruct(xField.Type) && !isTime(xField.Type) {
+		unwrapper := newUnwrapper(xField.Type)
+		if isStruct(xField.Type) && !isTime(xField.Type) && !isBigNumber(xField.Type) && unwrapper == nil {
 			checks.Structs = append(checks.Structs, &Field{Tag: tag, Field: xField})
 		} else if isSliceStruct(xField.Type) {
 			checks.Slices = append(checks.Slices, &Field{Tag: tag, Field: xField})
+		} else if isMapStruct(xField.Type) {
+			checks.Maps = append(checks.Maps, &Field{Tag: tag, Field: xField})
 		} else if xField.Type.Kind() == reflect.Slice && isPrimitive(xField.Type.Elem()) {
-			field := &Field{Tag: tag, Field: xField}
+			elementTag, collectionTag := splitCollectionTag(tag)
+			field := &Field{Tag: elementTag, Field: xField, owner: sType}
 			if ok {
-				fieldCheck, err := buildFieldCheck(sType, field, tag)
+				fieldCheck, err := buildFieldCheck(sType, field, elementTag, tagLiteral)
 				if err != nil {
 					return nil, err
 				}
 				field.FieldCheck = fieldCheck
 			}
 			checks.SimpleSlices = append(checks.SimpleSlices, field)
+			if len(collectionTag.Checks) > 0 {
+				collectionField := &Field{Tag: collectionTag, Field: xField, owner: sType}
+				fieldCheck, err := buildFieldCheck(sType, collectionField, collectionTag, tagLiteral)
+				if err != nil {
+					return nil, err
+				}
+				checks.Fields = append(checks.Fields, fieldCheck)
+			}
 			continue
 		}
 		if !ok || tagLiteral == "" {
 			continue
 		}
-		field := &Field{Tag: tag, Field: xField}
-		fieldCheck, err := buildFieldCheck(sType, field, tag)
+		field := &Field{Tag: tag, Field: xField, unwrapper: unwrapper, owner: sType}
+		fieldCheck, err := buildFieldCheck(sType, field, tag, tagLiteral)
 		if err != nil {
 			return nil, err
 		}
@@ -83,24 +98,59 @@ func NewChecks(t reflect.Type) (*Checks, error) {
 	return checks, nil
 }
 
-func buildFieldCheck(sType reflect.Type, field *Field, tag *Tag) (*FieldCheck, error) {
+func buildFieldCheck(sType reflect.Type, field *Field, tag *Tag, tagLiteral string) (*FieldCheck, error) {
 	fieldCheck := &FieldCheck{Owner: sType, Field: field}
+	checkField := field.checkField()
 	for i := range tag.Checks {
 		check := &tag.Checks[i]
+		if !check.Severity.IsValid() {
+			err := NewInvalidParameterError(check, string(check.Severity), "unsupported severity", nil)
+			return nil, withCheckContext(err, sType, field, check, tagLiteral)
+		}
 		newCheck := LookupAll(check.Name)
 		if newCheck == nil {
-			return nil, fmt.Errorf("unknown check: %v", check.Name)
+			return nil, withCheckContext(NewUnknownCheckError(check), sType, field, check, tagLiteral)
 		}
-		isValid, err := newCheck(field, check)
+		isValid, err := newCheck(checkField, check)
 		if err != nil {
-			return nil, err
+			return nil, withCheckContext(err, sType, field, check, tagLiteral)
+		}
+		var message *MessageTemplate
+		if IsMessageTemplate(check.Message) {
+			if message, err = NewMessageTemplate(check.Name, check.Message); err != nil {
+				err = NewInvalidParameterError(check, check.Message, "invalid message template", err)
+				return nil, withCheckContext(err, sType, field, check, tagLiteral)
+			}
 		}
 		fieldCheck.IsValid = append(fieldCheck.IsValid, isValid)
-
+		fieldCheck.Messages = append(fieldCheck.Messages, message)
 	}
 	return fieldCheck, nil
 }
 
+// splitCollectionTag splits primitive slice tag into per element and whole collection checks
+func splitCollectionTag(tag *Tag) (*Tag, *Tag) {
+	elementTag, collectionTag := *tag, *tag
+	elementTag.Checks, collectionTag.Checks = nil, nil
+	for _, check := range tag.Checks {
+		if IsCollection(check.Name) {
+			collectionTag.Checks = append(collectionTag.Checks, check)
+			continue
+		}
+		elementTag.Checks = append(elementTag.Checks, check)
+	}
+	return &elementTag, &collectionTag
+}
+
+// checkField returns field checks are built for, wrapper type field is represented by its underlying type
+func (f *Field) checkField() *Field {
+	if f.unwrapper == nil {
+		return f
+	}
+	xField := xunsafe.NewField(reflect.StructField{Name: f.Name, Type: f.unwrapper.Type, Tag: f.Field.Tag, Offset: f.Offset, Index: []int{int(f.Index)}})
+	return &Field{Tag: f.Tag, Field: xField, unwrapper: f.unwrapper, owner: f.owner}
+}
+
 func isPrimitive(t reflect.Type) bool {
 	if t.Kind() == reflect.String {
 		return true
@@ -121,6 +171,13 @@ func isSliceStruct(t reflect.Type) bool {
 	return false
 }
 
+func isMapStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Map && isStruct(t.Elem()) && !isTime(t.Elem()) && !isBigNumber(t.Elem()) && newUnwrapper(t.Elem()) == nil
+}
+
 func isStruct(t reflect.Type) bool {
 	if t.Kind() == reflect.Struct {
 		return true
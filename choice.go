@@ -5,37 +5,110 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 )
 
 type Choice struct {
-	intValue     map[int]bool
-	stringValues map[string]bool
+	intValues       map[int64]bool
+	uintValues      map[uint64]bool
+	floatValues     map[float64]bool
+	boolValues      map[bool]bool
+	stringValues    map[string]bool
+	caseInsensitive bool
 }
 
 func (c *Choice) checkInts(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
+	actual, isNil := derefReflectValue(value)
+	if isNil {
 		return false, nil
 	}
-	key := intValue(value)
-	return c.intValue[key], nil
+	return c.intValues[actual.Int()], nil
+}
+
+func (c *Choice) checkUints(ctx context.Context, value interface{}) (bool, error) {
+	actual, isNil := derefReflectValue(value)
+	if isNil {
+		return false, nil
+	}
+	return c.uintValues[actual.Uint()], nil
+}
+
+func (c *Choice) checkFloats(ctx context.Context, value interface{}) (bool, error) {
+	actual, isNil := derefReflectValue(value)
+	if isNil {
+		return false, nil
+	}
+	return c.floatValues[actual.Float()], nil
+}
+
+func (c *Choice) checkBools(ctx context.Context, value interface{}) (bool, error) {
+	actual, isNil := derefReflectValue(value)
+	if isNil {
+		return false, nil
+	}
+	return c.boolValues[actual.Bool()], nil
 }
 
 func (c *Choice) checkStrings(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
+	actual, isNil := derefReflectValue(value)
+	if isNil {
 		return false, nil
 	}
-	key := stringValue(value)
+	key := actual.String()
+	if c.caseInsensitive {
+		key = strings.ToLower(key)
+	}
 	return c.stringValues[key], nil
 }
 
-func (c *Choice) setInts(args []string) error {
-	c.intValue = make(map[int]bool)
+func (c *Choice) setInts(check *Check, args []string, bitSize int) error {
+	c.intValues = make(map[int64]bool)
 	for _, arg := range args {
-		key, err := strconv.Atoi(arg)
+		key, err := strconv.ParseInt(strings.TrimSpace(arg), 10, bitSize)
 		if err != nil {
-			return fmt.Errorf("%w invalid choice option expect int, but had: %v", err, arg)
+			return NewInvalidParameterError(check, arg, "expected integer choice option", err)
 		}
-		c.intValue[key] = true
+		c.intValues[key] = true
+	}
+	return nil
+}
+
+func (c *Choice) setUints(check *Check, args []string, bitSize int) error {
+	c.uintValues = make(map[uint64]bool)
+	for _, arg := range args {
+		key, err := strconv.ParseUint(strings.TrimSpace(arg), 10, bitSize)
+		if err != nil {
+			return NewInvalidParameterError(check, arg, "expected unsigned integer choice option", err)
+		}
+		c.uintValues[key] = true
+	}
+	return nil
+}
+
+func (c *Choice) setFloats(check *Check, args []string, bitSize int) error {
+	c.floatValues = make(map[float64]bool)
+	for _, arg := range args {
+		key, err := strconv.ParseFloat(strings.TrimSpace(arg), bitSize)
+		if err != nil {
+			return NewInvalidParameterError(check, arg, "expected float choice option", err)
+		}
+		if bitSize == 32 {
+			key = float64(float32(key))
+		}
+		c.floatValues[key] = true
+	}
+	return nil
+}
+
+func (c *Choice) setBools(check *Check, args []string) error {
+	c.boolValues = make(map[bool]bool)
+	for _, arg := range args {
+		key, err := strconv.ParseBool(strings.TrimSpace(arg))
+		if err != nil {
+			return NewInvalidParameterError(check, arg, "expected bool choice option", err)
+		}
+		c.boolValues[key] = true
 	}
 	return nil
 }
@@ -43,53 +116,177 @@ func (c *Choice) setInts(args []string) error {
 func (c *Choice) setStrings(args []string) {
 	c.stringValues = make(map[string]bool)
 	for i := range args {
-		c.stringValues[args[i]] = true
+		key := args[i]
+		if c.caseInsensitive {
+			key = strings.ToLower(key)
+		}
+		c.stringValues[key] = true
 	}
-
 }
 
 // NewChoice creates choice/enum value checks
 func NewChoice() func(field *Field, check *Check) (IsValid, error) {
+	return newChoice(false)
+}
+
+// NewCaseInsensitiveChoice creates string choice check matching values case insensitively, i.e. choice_ci(get,post)
+func NewCaseInsensitiveChoice() func(field *Field, check *Check) (IsValid, error) {
+	return newChoice(true)
+}
+
+func newChoice(caseInsensitive bool) func(field *Field, check *Check) (IsValid, error) {
 
 	return func(field *Field, check *Check) (IsValid, error) {
-		choice := &Choice{}
+		choice := &Choice{caseInsensitive: caseInsensitive}
 
-		if len(check.Parameters) == 0 {
-			return nil, fmt.Errorf("check option was empty")
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
 		}
-
-		switch field.Kind() {
-		case reflect.Int, reflect.Uint, reflect.Int64, reflect.Uint64:
-			if err := choice.setInts(check.Parameters); err != nil {
+		args := check.Parameters
+		kind, elemKind := typeKinds(field)
+		if kind == reflect.Slice {
+			if elemKind != reflect.String && elemKind != reflect.Int {
+				return nil, NewUnsupportedTypeError(field, check)
+			}
+			kind = elemKind
+		}
+		if caseInsensitive && kind != reflect.String {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if err := choice.setInts(check, args, kindBitSize(kind)); err != nil {
 				return nil, err
 			}
 			return choice.checkInts, nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if err := choice.setUints(check, args, kindBitSize(kind)); err != nil {
+				return nil, err
+			}
+			return choice.checkUints, nil
+		case reflect.Float32, reflect.Float64:
+			if err := choice.setFloats(check, args, kindBitSize(kind)); err != nil {
+				return nil, err
+			}
+			return choice.checkFloats, nil
+		case reflect.Bool:
+			if err := choice.setBools(check, args); err != nil {
+				return nil, err
+			}
+			return choice.checkBools, nil
 		case reflect.String:
-			choice.setStrings(check.Parameters)
+			choice.setStrings(args)
 			return choice.checkStrings, nil
-		case reflect.Slice:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				choice.setStrings(check.Parameters)
-				return choice.checkStrings, nil
-			case reflect.Int, reflect.Uint, reflect.Int64, reflect.Uint64:
-				if err := choice.setInts(check.Parameters); err != nil {
-					return nil, err
-				}
-				return choice.checkInts, nil
-			}
-		case reflect.Ptr:
-			switch field.Elem().Kind() {
-			case reflect.Int, reflect.Uint, reflect.Int64, reflect.Uint64:
-				if err := choice.setInts(check.Parameters); err != nil {
-					return nil, err
-				}
-				return choice.checkInts, nil
-			case reflect.String:
-				choice.setStrings(check.Parameters)
-				return choice.checkStrings, nil
+		}
+		return nil, NewUnsupportedTypeError(field, check)
+	}
+}
+
+func kindBitSize(kind reflect.Kind) int {
+	switch kind {
+	case reflect.Int8, reflect.Uint8:
+		return 8
+	case reflect.Int16, reflect.Uint16:
+		return 16
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 32
+	}
+	return 64
+}
+
+type enumRegistry struct {
+	values map[reflect.Type]map[interface{}]bool
+	sync.RWMutex
+}
+
+func (r *enumRegistry) register(t reflect.Type, values map[interface{}]bool) {
+	r.Lock()
+	r.values[t] = values
+	r.Unlock()
+}
+
+func (r *enumRegistry) lookup(t reflect.Type) map[interface{}]bool {
+	r.RLock()
+	ret := r.values[t]
+	r.RUnlock()
+	return ret
+}
+
+var _enums = &enumRegistry{values: map[reflect.Type]map[interface{}]bool{}}
+
+// RegisterEnum registers allowed values of enum type for enum check, values has to be non empty slice of that type, i.e. []Status{Active, Inactive}
+func RegisterEnum(values interface{}) error {
+	slice := reflect.ValueOf(values)
+	if slice.Kind() != reflect.Slice || slice.Len() == 0 {
+		return fmt.Errorf("expected non empty slice of enum values, but had: %T", values)
+	}
+	elemType := slice.Type().Elem()
+	if !elemType.Comparable() {
+		return fmt.Errorf("enum type %v is not comparable", elemType)
+	}
+	_enums.register(elemType, enumValues(slice))
+	return nil
+}
+
+type enumCheck struct {
+	values map[interface{}]bool
+}
+
+func (e *enumCheck) check(ctx context.Context, value interface{}) (bool, error) {
+	actual, isNil := derefReflectValue(value)
+	if isNil {
+		return false, nil
+	}
+	return e.values[actual.Interface()], nil
+}
+
+// NewEnum creates enum check, allowed values are registered with RegisterEnum or returned by Enum() []T method of the field type T
+func NewEnum() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 0, 0); err != nil {
+			return nil, err
+		}
+		enumType := field.Type
+		if enumType.Kind() == reflect.Ptr {
+			enumType = enumType.Elem()
+		}
+		if enumType.Kind() == reflect.Slice {
+			if !isPrimitive(enumType.Elem()) {
+				return nil, NewUnsupportedTypeError(field, check)
 			}
+			enumType = enumType.Elem()
+		}
+		if values := _enums.lookup(enumType); values != nil {
+			return (&enumCheck{values: values}).check, nil
+		}
+		if values := enumMethodValues(enumType); values != nil {
+			return (&enumCheck{values: values}).check, nil
 		}
-		return nil, fmt.Errorf("not supported for: %v", field.Type.String())
+		return nil, NewUnsupportedTypeError(field, check)
+	}
+}
+
+// enumMethodValues returns values of Enum() []T method of T, or nil if T does not implement it
+func enumMethodValues(t reflect.Type) map[interface{}]bool {
+	if !t.Comparable() {
+		return nil
+	}
+	receiver := reflect.New(t)
+	method := receiver.MethodByName("Enum")
+	if !method.IsValid() {
+		return nil
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 || methodType.Out(0) != reflect.SliceOf(t) {
+		return nil
+	}
+	return enumValues(method.Call(nil)[0])
+}
+
+func enumValues(slice reflect.Value) map[interface{}]bool {
+	ret := make(map[interface{}]bool, slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		ret[slice.Index(i).Interface()] = true
 	}
+	return ret
 }
@@ -0,0 +1,153 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testStatus string
+
+	testPriority int
+)
+
+const (
+	testStatusActive   = testStatus("active")
+	testStatusInactive = testStatus("inactive")
+)
+
+func (s testStatus) Enum() []testStatus {
+	return []testStatus{testStatusActive, testStatusInactive}
+}
+
+func TestService_Validate_Choice(t *testing.T) {
+	if !assert.Nil(t, RegisterEnum([]testPriority{1, 2, 3})) {
+		return
+	}
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "float choice",
+			input: struct {
+				Ratio  float64 `validate:"choice(0.5,1,1.5)"`
+				Factor float32 `validate:"choice(0.1,0.2)"`
+			}{Ratio: 1.5, Factor: 0.2},
+		},
+		{
+			description: "float choice fails",
+			input: struct {
+				Ratio *float64 `validate:"choice(0.5,1)"`
+			}{Ratio: float64Ptr(2)},
+			expectPaths: []string{"Ratio"},
+		},
+		{
+			description: "bool choice",
+			input: struct {
+				Enabled bool `validate:"choice(true)"`
+			}{},
+			expectPaths: []string{"Enabled"},
+		},
+		{
+			description: "small int kinds",
+			input: struct {
+				Level int8   `validate:"choice(-1,0,1)"`
+				Code  int32  `validate:"choice(200,404)"`
+				Flag  uint8  `validate:"choice(1,2)"`
+				Port  uint16 `validate:"choice(80,443)"`
+			}{Level: -1, Code: 500, Flag: 2, Port: 80},
+			expectPaths: []string{"Code"},
+		},
+		{
+			description: "out of range option",
+			input: struct {
+				Flag uint8 `validate:"choice(256)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "case insensitive choice",
+			input: struct {
+				Method string   `validate:"choice_ci(get,post)"`
+				Tags   []string `validate:"oneof_ci(a,b)"`
+			}{Method: "GET", Tags: []string{"A", "c"}},
+			expectPaths: []string{"Tags[1]"},
+		},
+		{
+			description: "ci is a regular option",
+			input: struct {
+				Country string `validate:"choice(ci,us,gb)"`
+				Other   string `validate:"choice(ci,us,gb)"`
+			}{Country: "ci", Other: "CI"},
+			expectPaths: []string{"Other"},
+		},
+		{
+			description: "case insensitive choice on non string",
+			input: struct {
+				Code int `validate:"choice_ci(1,2)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "case sensitive choice",
+			input: struct {
+				Method string `validate:"choice(get,post)"`
+			}{Method: "GET"},
+			expectPaths: []string{"Method"},
+		},
+		{
+			description: "enum method",
+			input: struct {
+				Status   testStatus   `validate:"enum"`
+				Previous *testStatus  `validate:"enum,omitempty"`
+				History  []testStatus `validate:"enum"`
+			}{Status: testStatusActive, History: []testStatus{testStatusInactive, "deleted"}},
+			expectPaths: []string{"History[1]"},
+		},
+		{
+			description: "registered enum",
+			input: struct {
+				Priority testPriority `validate:"enum"`
+			}{Priority: 4},
+			expectPaths: []string{"Priority"},
+		},
+		{
+			description: "enum without values",
+			input: struct {
+				Name string `validate:"enum"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func TestRegisterEnum(t *testing.T) {
+	assert.NotNil(t, RegisterEnum(nil))
+	assert.NotNil(t, RegisterEnum([]testStatus{}))
+	assert.NotNil(t, RegisterEnum([][]string{{"a"}}))
+}
+
+func float64Ptr(value float64) *float64 {
+	return &value
+}
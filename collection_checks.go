@@ -0,0 +1,251 @@
+package govalidator
+
+import (
+	"context"
+	"reflect"
+	"strings"
+)
+
+type collectionCheck struct {
+	key    *fieldAccessor
+	desc   bool
+	values []string
+}
+
+func (c *collectionCheck) unique(ctx context.Context, value interface{}) (bool, error) {
+	slice, isNil := derefReflectValue(value)
+	if isNil {
+		return true, nil
+	}
+	seen := make(map[interface{}]bool, slice.Len())
+	passed := true
+	for i := 0; i < slice.Len(); i++ {
+		key, ok := c.elementKey(slice.Index(i))
+		if !ok {
+			continue
+		}
+		if seen[key] {
+			reportElement(ctx, i, slice.Index(i).Interface())
+			passed = false
+			continue
+		}
+		seen[key] = true
+	}
+	return passed, nil
+}
+
+func (c *collectionCheck) sorted(ctx context.Context, value interface{}) (bool, error) {
+	slice, isNil := derefReflectValue(value)
+	if isNil {
+		return true, nil
+	}
+	var prev interface{}
+	for i := 0; i < slice.Len(); i++ {
+		key, ok := c.elementKey(slice.Index(i))
+		if !ok {
+			continue
+		}
+		if prev != nil {
+			cmp, err := compareValues(prev, key)
+			if err != nil {
+				return false, nil
+			}
+			if (c.desc && cmp < 0) || (!c.desc && cmp > 0) {
+				return false, nil
+			}
+		}
+		prev = key
+	}
+	return true, nil
+}
+
+func (c *collectionCheck) containsAll(ctx context.Context, value interface{}) (bool, error) {
+	slice, _ := derefReflectValue(value)
+	for _, expected := range c.values {
+		if c.indexOf(slice, expected) == -1 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (c *collectionCheck) containsAny(ctx context.Context, value interface{}) (bool, error) {
+	slice, _ := derefReflectValue(value)
+	for _, expected := range c.values {
+		if c.indexOf(slice, expected) != -1 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *collectionCheck) excludesAll(ctx context.Context, value interface{}) (bool, error) {
+	slice, isNil := derefReflectValue(value)
+	if isNil {
+		return true, nil
+	}
+	passed := true
+	for i := 0; i < slice.Len(); i++ {
+		item := slice.Index(i).Interface()
+		for _, excluded := range c.values {
+			if equalsParamValue(item, excluded) {
+				reportElement(ctx, i, item)
+				passed = false
+				break
+			}
+		}
+	}
+	return passed, nil
+}
+
+func (c *collectionCheck) indexOf(slice reflect.Value, expected string) int {
+	if !slice.IsValid() {
+		return -1
+	}
+	for i := 0; i < slice.Len(); i++ {
+		if equalsParamValue(slice.Index(i).Interface(), expected) {
+			return i
+		}
+	}
+	return -1
+}
+
+// elementKey returns element or element key field value, nil elements are skipped
+func (c *collectionCheck) elementKey(item reflect.Value) (interface{}, bool) {
+	var key interface{}
+	if c.key != nil {
+		if item.Kind() == reflect.Ptr && item.IsNil() {
+			return nil, false
+		}
+		var err error
+		if key, err = unwrapValue(c.key.value(item.Interface())); err != nil {
+			return nil, false
+		}
+	} else {
+		actual, isNil := derefReflectValue(item.Interface())
+		if isNil {
+			return nil, false
+		}
+		key = actual.Interface()
+	}
+	if key == nil || !reflect.TypeOf(key).Comparable() {
+		return nil, false
+	}
+	return key, true
+}
+
+// NewUnique creates unique elements check, slice of structs uses key field, i.e. unique(ID)
+func NewUnique() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 0, 1); err != nil {
+			return nil, err
+		}
+		elemType, err := collectionElemType(field, check)
+		if err != nil {
+			return nil, err
+		}
+		ret := &collectionCheck{}
+		if len(check.Parameters) == 1 {
+			if ret.key, err = newCollectionKey(elemType, check, check.Parameters[0]); err != nil {
+				return nil, err
+			}
+		} else if !elemType.Comparable() {
+			return nil, NewInvalidParameterError(check, "", "expects key field for non comparable "+elemType.String()+" elements", nil)
+		}
+		return ret.unique, nil
+	}
+}
+
+// NewSorted creates sorted elements check, i.e. sorted, sorted(desc), sorted(Price) or sorted(Price,desc)
+func NewSorted() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 0, 2); err != nil {
+			return nil, err
+		}
+		elemType, err := collectionElemType(field, check)
+		if err != nil {
+			return nil, err
+		}
+		ret := &collectionCheck{}
+		params := check.Parameters
+		if count := len(params); count > 0 {
+			switch strings.ToLower(params[count-1]) {
+			case "desc":
+				ret.desc = true
+				params = params[:count-1]
+			case "asc":
+				params = params[:count-1]
+			}
+		}
+		switch len(params) {
+		case 0:
+			if !isNumericKind(elemType.Kind()) && elemType.Kind() != reflect.String && !isTime(elemType) {
+				return nil, NewUnsupportedTypeError(field, check)
+			}
+		case 1:
+			if ret.key, err = newCollectionKey(elemType, check, params[0]); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, NewInvalidParameterError(check, strings.Join(check.Parameters, ","), "expects key field and asc or desc direction", nil)
+		}
+		return ret.sorted, nil
+	}
+}
+
+// NewContainsAll creates check that slice contains all listed values
+func NewContainsAll() func(field *Field, check *Check) (IsValid, error) {
+	return newCollectionValuesCheck(func(c *collectionCheck) IsValid {
+		return c.containsAll
+	})
+}
+
+// NewContainsAny creates check that slice contains at least one of listed values
+func NewContainsAny() func(field *Field, check *Check) (IsValid, error) {
+	return newCollectionValuesCheck(func(c *collectionCheck) IsValid {
+		return c.containsAny
+	})
+}
+
+// NewExcludesAll creates check that slice contains none of listed values, violations are reported at excluded elements
+func NewExcludesAll() func(field *Field, check *Check) (IsValid, error) {
+	return newCollectionValuesCheck(func(c *collectionCheck) IsValid {
+		return c.excludesAll
+	})
+}
+
+func newCollectionValuesCheck(factory func(c *collectionCheck) IsValid) func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
+		}
+		if _, err := collectionElemType(field, check); err != nil {
+			return nil, err
+		}
+		return factory(&collectionCheck{values: check.Parameters}), nil
+	}
+}
+
+// collectionElemType returns slice element type, pointer elements are dereferenced
+func collectionElemType(field *Field, check *Check) (reflect.Type, error) {
+	t := field.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return nil, NewUnsupportedTypeError(field, check)
+	}
+	elemType := t.Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	return elemType, nil
+}
+
+func newCollectionKey(elemType reflect.Type, check *Check, literal string) (*fieldAccessor, error) {
+	ret, err := newFieldAccessor(elemType, strings.Split(strings.TrimSpace(literal), "."))
+	if err != nil {
+		return nil, NewInvalidParameterError(check, literal, "unknown key field", err)
+	}
+	return ret, nil
+}
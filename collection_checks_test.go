@@ -0,0 +1,141 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testDeal struct {
+	ID    string
+	Price float64
+}
+
+func TestService_Validate_CollectionChecks(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "unique strings",
+			input: struct {
+				Segments []string `validate:"unique"`
+			}{Segments: []string{"a", "b", "c"}},
+		},
+		{
+			description: "duplicate strings reported at duplicate index",
+			input: struct {
+				Segments []string `validate:"unique"`
+			}{Segments: []string{"a", "b", "a", "b", "a"}},
+			expectPaths: []string{"Segments[2]", "Segments[3]", "Segments[4]"},
+		},
+		{
+			description: "unique with element checks",
+			input: struct {
+				IDs []int `validate:"min(1),unique"`
+			}{IDs: []int{0, 2, 2}},
+			expectPaths: []string{"IDs[2]", "IDs[0]"},
+		},
+		{
+			description: "unique int64",
+			input: struct {
+				IDs []int64 `validate:"unique"`
+			}{IDs: []int64{7, 7}},
+			expectPaths: []string{"IDs[1]"},
+		},
+		{
+			description: "unique struct key",
+			input: struct {
+				Deals []*testDeal `validate:"unique(ID)"`
+			}{Deals: []*testDeal{{ID: "d1"}, nil, {ID: "d2"}, {ID: "d1"}}},
+			expectPaths: []string{"Deals[3]"},
+		},
+		{
+			description: "sorted",
+			input: struct {
+				Values []float64 `validate:"sorted"`
+			}{Values: []float64{1, 1.5, 1.5, 3}},
+		},
+		{
+			description: "sorted desc fails",
+			input: struct {
+				Values []string `validate:"sorted(desc)"`
+			}{Values: []string{"c", "a", "b"}},
+			expectPaths: []string{"Values"},
+		},
+		{
+			description: "sorted by key",
+			input: struct {
+				Deals []testDeal `validate:"sorted(Price,desc)"`
+			}{Deals: []testDeal{{Price: 3}, {Price: 2}, {Price: 2.5}}},
+			expectPaths: []string{"Deals"},
+		},
+		{
+			description: "containsall",
+			input: struct {
+				Tags []string `validate:"containsall(a,b)"`
+			}{Tags: []string{"b", "c", "a"}},
+		},
+		{
+			description: "containsall fails",
+			input: struct {
+				IDs []int `validate:"containsall(1,2)"`
+			}{IDs: []int{1, 3}},
+			expectPaths: []string{"IDs"},
+		},
+		{
+			description: "containsany fails",
+			input: struct {
+				Tags []string `validate:"containsany(x,y)"`
+			}{Tags: []string{"a"}},
+			expectPaths: []string{"Tags"},
+		},
+		{
+			description: "excludesall reported at element",
+			input: struct {
+				Tags []string `validate:"excludesall(x,y)"`
+			}{Tags: []string{"a", "y", "b", "x"}},
+			expectPaths: []string{"Tags[1]", "Tags[3]"},
+		},
+		{
+			description: "unique struct without key",
+			input: struct {
+				Deals []testDeal `validate:"unique"`
+			}{Deals: []testDeal{{ID: "1"}, {ID: "1"}}},
+			expectPaths: []string{"Deals[1]"},
+		},
+		{
+			description: "unknown key field",
+			input: struct {
+				Deals []testDeal `validate:"unique(Name)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Name string `validate:"unique"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
@@ -2,6 +2,7 @@ package govalidator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"reflect"
@@ -12,32 +13,36 @@ import (
 )
 
 type boundsCheck struct {
-	min float64
-	max float64
+	min *numericParam
+	max *numericParam
 }
 
 func (b *boundsCheck) minNumeric(ctx context.Context, value interface{}) (bool, error) {
-	actual, ok := numericValue(value)
+	cmp, ok := b.min.compare(value)
 	if !ok {
 		return false, nil
 	}
-	return actual >= b.min, nil
+	return cmp >= 0, nil
 }
 
 func (b *boundsCheck) maxNumeric(ctx context.Context, value interface{}) (bool, error) {
-	actual, ok := numericValue(value)
+	cmp, ok := b.max.compare(value)
 	if !ok {
 		return false, nil
 	}
-	return actual <= b.max, nil
+	return cmp <= 0, nil
 }
 
 func (b *boundsCheck) betweenNumeric(ctx context.Context, value interface{}) (bool, error) {
-	actual, ok := numericValue(value)
+	minCmp, ok := b.min.compare(value)
 	if !ok {
 		return false, nil
 	}
-	return actual >= b.min && actual <= b.max, nil
+	maxCmp, ok := b.max.compare(value)
+	if !ok {
+		return false, nil
+	}
+	return minCmp >= 0 && maxCmp <= 0, nil
 }
 
 func (b *boundsCheck) minLen(ctx context.Context, value interface{}) (bool, error) {
@@ -45,7 +50,7 @@ func (b *boundsCheck) minLen(ctx context.Context, value interface{}) (bool, erro
 	if !ok {
 		return false, nil
 	}
-	return actual >= int(b.min), nil
+	return int64(actual) >= b.min.int, nil
 }
 
 func (b *boundsCheck) maxLen(ctx context.Context, value interface{}) (bool, error) {
@@ -53,7 +58,7 @@ func (b *boundsCheck) maxLen(ctx context.Context, value interface{}) (bool, erro
 	if !ok {
 		return false, nil
 	}
-	return actual <= int(b.max), nil
+	return int64(actual) <= b.max.int, nil
 }
 
 func (b *boundsCheck) betweenLen(ctx context.Context, value interface{}) (bool, error) {
@@ -61,154 +66,79 @@ func (b *boundsCheck) betweenLen(ctx context.Context, value interface{}) (bool,
 	if !ok {
 		return false, nil
 	}
-	return actual >= int(b.min) && actual <= int(b.max), nil
+	return int64(actual) >= b.min.int && int64(actual) <= b.max.int, nil
 }
 
 func NewMin() func(field *Field, check *Check) (IsValid, error) {
-	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) != 1 {
-			return nil, fmt.Errorf("min expects 1 parameter, but had: %d", len(check.Parameters))
-		}
-		param, err := strconv.ParseFloat(check.Parameters[0], 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid min parameter: %w", err)
-		}
-		ret := &boundsCheck{min: param}
-		kind, elemKind := typeKinds(field)
-
-		switch kind {
-		case reflect.String:
-			if !isWholeNumber(param) {
-				return nil, fmt.Errorf("min for string expects integer, but had: %v", check.Parameters[0])
-			}
-			return ret.minLen, nil
-		case reflect.Slice:
-			switch elemKind {
-			case reflect.String:
-				if !isWholeNumber(param) {
-					return nil, fmt.Errorf("min for string expects integer, but had: %v", check.Parameters[0])
-				}
-				return ret.minLen, nil
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-				reflect.Float32, reflect.Float64:
-				return ret.minNumeric, nil
-			case reflect.Slice, reflect.Struct, reflect.Ptr:
-				if !isWholeNumber(param) {
-					return nil, fmt.Errorf("min for slice expects integer, but had: %v", check.Parameters[0])
-				}
-				return ret.minLen, nil
-			}
-			if !isWholeNumber(param) {
-				return nil, fmt.Errorf("min for slice expects integer, but had: %v", check.Parameters[0])
-			}
-			return ret.minLen, nil
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-			reflect.Float32, reflect.Float64:
-			return ret.minNumeric, nil
+	return newBoundsCheck(1, func(b *boundsCheck, isLen bool) IsValid {
+		if isLen {
+			return b.minLen
 		}
-		return nil, fmt.Errorf("unsupported min type: %s", field.Type.String())
-	}
+		return b.minNumeric
+	})
 }
 
 func NewMax() func(field *Field, check *Check) (IsValid, error) {
-	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) != 1 {
-			return nil, fmt.Errorf("max expects 1 parameter, but had: %d", len(check.Parameters))
+	return newBoundsCheck(1, func(b *boundsCheck, isLen bool) IsValid {
+		if isLen {
+			return b.maxLen
 		}
-		param, err := strconv.ParseFloat(check.Parameters[0], 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid max parameter: %w", err)
-		}
-		ret := &boundsCheck{max: param}
-		kind, elemKind := typeKinds(field)
+		return b.maxNumeric
+	})
+}
 
-		switch kind {
-		case reflect.String:
-			if !isWholeNumber(param) {
-				return nil, fmt.Errorf("max for string expects integer, but had: %v", check.Parameters[0])
-			}
-			return ret.maxLen, nil
-		case reflect.Slice:
-			switch elemKind {
-			case reflect.String:
-				if !isWholeNumber(param) {
-					return nil, fmt.Errorf("max for string expects integer, but had: %v", check.Parameters[0])
-				}
-				return ret.maxLen, nil
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-				reflect.Float32, reflect.Float64:
-				return ret.maxNumeric, nil
-			case reflect.Slice, reflect.Struct, reflect.Ptr:
-				if !isWholeNumber(param) {
-					return nil, fmt.Errorf("max for slice expects integer, but had: %v", check.Parameters[0])
-				}
-				return ret.maxLen, nil
-			}
-			if !isWholeNumber(param) {
-				return nil, fmt.Errorf("max for slice expects integer, but had: %v", check.Parameters[0])
-			}
-			return ret.maxLen, nil
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-			reflect.Float32, reflect.Float64:
-			return ret.maxNumeric, nil
+func NewBetween() func(field *Field, check *Check) (IsValid, error) {
+	return newBoundsCheck(2, func(b *boundsCheck, isLen bool) IsValid {
+		if isLen {
+			return b.betweenLen
 		}
-		return nil, fmt.Errorf("unsupported max type: %s", field.Type.String())
-	}
+		return b.betweenNumeric
+	})
 }
 
-func NewBetween() func(field *Field, check *Check) (IsValid, error) {
+// newBoundsCheck creates min (single parameter), max (single parameter) or between (min,max parameters) check
+func newBoundsCheck(paramCount int, factory func(b *boundsCheck, isLen bool) IsValid) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) != 2 {
-			return nil, fmt.Errorf("between expects 2 parameters, but had: %d", len(check.Parameters))
+		if err := expectParameters(check, paramCount, paramCount); err != nil {
+			return nil, err
 		}
-		min, err := strconv.ParseFloat(check.Parameters[0], 64)
+		params, durationString, err := newNumericParams(field, check)
 		if err != nil {
-			return nil, fmt.Errorf("invalid between min parameter: %w", err)
+			return nil, err
 		}
-		max, err := strconv.ParseFloat(check.Parameters[1], 64)
-		if err != nil {
-			return nil, fmt.Errorf("invalid between max parameter: %w", err)
+		ret := &boundsCheck{min: params[0], max: params[len(params)-1]}
+		if ret.min.rat.Cmp(ret.max.rat) > 0 {
+			return nil, NewInvalidParameterError(check, check.Parameters[0], "expected min not greater than max", nil)
+		}
+		if isBigNumber(field.Type) {
+			return factory(ret, false), nil
+		}
+		if field.isNumberString() {
+			return numberStringCheck(factory(ret, false)), nil
+		}
+		if durationString {
+			return durationStringCheck(factory(ret, false)), nil
 		}
-		ret := &boundsCheck{min: min, max: max}
 		kind, elemKind := typeKinds(field)
-
+		isLen := false
 		switch kind {
 		case reflect.String:
-			if !isWholeNumber(min) || !isWholeNumber(max) {
-				return nil, fmt.Errorf("between for string expects integers, but had: %v,%v", check.Parameters[0], check.Parameters[1])
-			}
-			return ret.betweenLen, nil
+			isLen = true
 		case reflect.Slice:
-			switch elemKind {
-			case reflect.String:
-				if !isWholeNumber(min) || !isWholeNumber(max) {
-					return nil, fmt.Errorf("between for string expects integers, but had: %v,%v", check.Parameters[0], check.Parameters[1])
-				}
-				return ret.betweenLen, nil
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-				reflect.Float32, reflect.Float64:
-				return ret.betweenNumeric, nil
-			case reflect.Slice, reflect.Struct, reflect.Ptr:
-				if !isWholeNumber(min) || !isWholeNumber(max) {
-					return nil, fmt.Errorf("between for slice expects integers, but had: %v,%v", check.Parameters[0], check.Parameters[1])
-				}
-				return ret.betweenLen, nil
+			isLen = !isNumericKind(elemKind)
+		default:
+			if !isNumericKind(kind) {
+				return nil, NewUnsupportedTypeError(field, check)
 			}
-			if !isWholeNumber(min) || !isWholeNumber(max) {
-				return nil, fmt.Errorf("between for slice expects integers, but had: %v,%v", check.Parameters[0], check.Parameters[1])
+		}
+		if isLen {
+			for _, param := range params {
+				if !param.isInt {
+					return nil, NewInvalidParameterError(check, param.literal, "length expects integer", nil)
+				}
 			}
-			return ret.betweenLen, nil
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-			reflect.Float32, reflect.Float64:
-			return ret.betweenNumeric, nil
 		}
-		return nil, fmt.Errorf("unsupported between type: %s", field.Type.String())
+		return factory(ret, isLen), nil
 	}
 }
 
@@ -251,52 +181,52 @@ func (s *stringCheck) endsWith(ctx context.Context, value interface{}) (bool, er
 func NewContains() func(field *Field, check *Check) (IsValid, error) {
 	return newStringCheck(func(s *stringCheck) IsValid {
 		return s.contains
-	}, "contains")
+	})
 }
 
 func NewNotContains() func(field *Field, check *Check) (IsValid, error) {
 	return newStringCheck(func(s *stringCheck) IsValid {
 		return s.notContains
-	}, "notcontains")
+	})
 }
 
 func NewStartsWith() func(field *Field, check *Check) (IsValid, error) {
 	return newStringCheck(func(s *stringCheck) IsValid {
 		return s.startsWith
-	}, "startswith")
+	})
 }
 
 func NewEndsWith() func(field *Field, check *Check) (IsValid, error) {
 	return newStringCheck(func(s *stringCheck) IsValid {
 		return s.endsWith
-	}, "endswith")
+	})
 }
 
-func newStringCheck(factory func(*stringCheck) IsValid, name string) func(field *Field, check *Check) (IsValid, error) {
+func newStringCheck(factory func(*stringCheck) IsValid) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) != 1 {
-			return nil, fmt.Errorf("%s expects 1 parameter, but had: %d", name, len(check.Parameters))
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
 		}
 		kind, elemKind := typeKinds(field)
 		switch kind {
 		case reflect.String:
 		case reflect.Slice:
 			if elemKind != reflect.String {
-				return nil, fmt.Errorf("unsupported %s type: %s", name, field.Type.String())
+				return nil, NewUnsupportedTypeError(field, check)
 			}
 		default:
-			return nil, fmt.Errorf("unsupported %s type: %s", name, field.Type.String())
+			return nil, NewUnsupportedTypeError(field, check)
 		}
 		return factory(&stringCheck{arg: check.Parameters[0]}), nil
 	}
 }
 
 type fieldCheck struct {
-	other string
+	other *fieldRef
 }
 
 func (f *fieldCheck) eqField(ctx context.Context, value interface{}) (bool, error) {
-	otherValue, err := lookupOtherField(ctx, f.other)
+	otherValue, err := f.other.Value(ctx)
 	if err != nil {
 		return false, err
 	}
@@ -304,178 +234,306 @@ func (f *fieldCheck) eqField(ctx context.Context, value interface{}) (bool, erro
 }
 
 func (f *fieldCheck) neField(ctx context.Context, value interface{}) (bool, error) {
-	otherValue, err := lookupOtherField(ctx, f.other)
+	otherValue, err := f.other.Value(ctx)
 	if err != nil {
 		return false, err
 	}
 	return !equalValues(value, otherValue), nil
 }
 
-func (f *fieldCheck) gtField(ctx context.Context, value interface{}) (bool, error) {
-	otherValue, err := lookupOtherField(ctx, f.other)
-	if err != nil {
-		return false, err
-	}
-	compare, err := compareValues(value, otherValue)
-	if err != nil {
-		return false, err
+func (f *fieldCheck) compareField(predicate func(cmp int) bool) IsValid {
+	return func(ctx context.Context, value interface{}) (bool, error) {
+		otherValue, err := f.other.Value(ctx)
+		if err != nil {
+			return false, err
+		}
+		if _, isNil := derefReflectValue(otherValue); isNil { //missing reference, i.e. nil intermediate pointer, is not comparable
+			return false, nil
+		}
+		if _, isNil := derefReflectValue(value); isNil {
+			return false, nil
+		}
+		compare, err := compareValues(value, otherValue)
+		if err == errNotComparable {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return predicate(compare), nil
 	}
-	return compare > 0, nil
 }
 
 func NewEqField() func(field *Field, check *Check) (IsValid, error) {
 	return newCrossFieldCheck(func(c *fieldCheck) IsValid {
 		return c.eqField
-	}, "eqfield")
+	})
 }
 
 func NewNeField() func(field *Field, check *Check) (IsValid, error) {
 	return newCrossFieldCheck(func(c *fieldCheck) IsValid {
 		return c.neField
-	}, "nefield")
+	})
 }
 
 func NewGtField() func(field *Field, check *Check) (IsValid, error) {
 	return newCrossFieldCheck(func(c *fieldCheck) IsValid {
-		return c.gtField
-	}, "gtfield")
+		return c.compareField(func(cmp int) bool {
+			return cmp > 0
+		})
+	})
+}
+
+// NewGeField creates greater or equal than other field check
+func NewGeField() func(field *Field, check *Check) (IsValid, error) {
+	return newCrossFieldCheck(func(c *fieldCheck) IsValid {
+		return c.compareField(func(cmp int) bool {
+			return cmp >= 0
+		})
+	})
+}
+
+// NewLtField creates less than other field check
+func NewLtField() func(field *Field, check *Check) (IsValid, error) {
+	return newCrossFieldCheck(func(c *fieldCheck) IsValid {
+		return c.compareField(func(cmp int) bool {
+			return cmp < 0
+		})
+	})
 }
 
-func newCrossFieldCheck(factory func(*fieldCheck) IsValid, name string) func(field *Field, check *Check) (IsValid, error) {
+// NewLeField creates less or equal than other field check
+func NewLeField() func(field *Field, check *Check) (IsValid, error) {
+	return newCrossFieldCheck(func(c *fieldCheck) IsValid {
+		return c.compareField(func(cmp int) bool {
+			return cmp <= 0
+		})
+	})
+}
+
+func newCrossFieldCheck(factory func(*fieldCheck) IsValid) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) != 1 {
-			return nil, fmt.Errorf("%s expects 1 parameter, but had: %d", name, len(check.Parameters))
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		other, err := newFieldRef(field.owner, check, check.Parameters[0])
+		if err != nil {
+			return nil, err
 		}
-		return factory(&fieldCheck{other: check.Parameters[0]}), nil
+		return factory(&fieldCheck{other: other}), nil
 	}
 }
 
 type conditionalRequiredCheck struct {
-	otherField   string
-	expected     string
-	otherFields  []string
+	conditions   []*fieldCondition
+	otherFields  []*fieldRef
 	checkCurrent func(current interface{}, condition bool) (bool, error)
 }
 
-func (c *conditionalRequiredCheck) requiredIf(ctx context.Context, value interface{}) (bool, error) {
-	otherValue, err := lookupOtherField(ctx, c.otherField)
+// fieldCondition represents other field expected values condition, i.e. Type,a|b
+type fieldCondition struct {
+	field    *fieldRef
+	expected []string
+}
+
+func (f *fieldCondition) matches(ctx context.Context) (bool, error) {
+	otherValue, err := f.field.Value(ctx)
 	if err != nil {
 		return false, err
 	}
-	condition := equalsParamValue(otherValue, c.expected)
-	return c.checkCurrent(value, condition)
+	for _, expected := range f.expected {
+		if equalsParamValue(otherValue, expected) {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
-func (c *conditionalRequiredCheck) requiredUnless(ctx context.Context, value interface{}) (bool, error) {
-	otherValue, err := lookupOtherField(ctx, c.otherField)
-	if err != nil {
-		return false, err
+// conditionsMatch returns true if all field conditions match
+func (c *conditionalRequiredCheck) conditionsMatch(ctx context.Context) (bool, error) {
+	for _, condition := range c.conditions {
+		matched, err := condition.matches(ctx)
+		if err != nil || !matched {
+			return false, err
+		}
 	}
-	condition := !equalsParamValue(otherValue, c.expected)
-	return c.checkCurrent(value, condition)
+	return true, nil
 }
 
-func (c *conditionalRequiredCheck) requiredWith(ctx context.Context, value interface{}) (bool, error) {
-	condition := false
+// presentFields returns number of other fields that are not empty
+func (c *conditionalRequiredCheck) presentFields(ctx context.Context) (int, error) {
+	present := 0
 	for _, other := range c.otherFields {
-		otherValue, err := lookupOtherField(ctx, other)
+		otherValue, err := other.Value(ctx)
 		if err != nil {
-			return false, err
+			return 0, err
 		}
 		if !isEmpty(otherValue) {
-			condition = true
-			break
+			present++
 		}
 	}
+	return present, nil
+}
+
+func (c *conditionalRequiredCheck) requiredIf(ctx context.Context, value interface{}) (bool, error) {
+	condition, err := c.conditionsMatch(ctx)
+	if err != nil {
+		return false, err
+	}
 	return c.checkCurrent(value, condition)
 }
 
+func (c *conditionalRequiredCheck) requiredUnless(ctx context.Context, value interface{}) (bool, error) {
+	condition, err := c.conditionsMatch(ctx)
+	if err != nil {
+		return false, err
+	}
+	return c.checkCurrent(value, !condition)
+}
+
+func (c *conditionalRequiredCheck) requiredWith(ctx context.Context, value interface{}) (bool, error) {
+	present, err := c.presentFields(ctx)
+	if err != nil {
+		return false, err
+	}
+	return c.checkCurrent(value, present > 0)
+}
+
+func (c *conditionalRequiredCheck) requiredWithAll(ctx context.Context, value interface{}) (bool, error) {
+	present, err := c.presentFields(ctx)
+	if err != nil {
+		return false, err
+	}
+	return c.checkCurrent(value, present == len(c.otherFields))
+}
+
 func (c *conditionalRequiredCheck) requiredWithout(ctx context.Context, value interface{}) (bool, error) {
-	condition := false
-	for _, other := range c.otherFields {
-		otherValue, err := lookupOtherField(ctx, other)
-		if err != nil {
-			return false, err
-		}
-		if isEmpty(otherValue) {
-			condition = true
-			break
-		}
+	present, err := c.presentFields(ctx)
+	if err != nil {
+		return false, err
 	}
-	return c.checkCurrent(value, condition)
+	return c.checkCurrent(value, present < len(c.otherFields))
 }
 
-func NewRequiredIf() func(field *Field, check *Check) (IsValid, error) {
-	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) != 2 {
-			return nil, fmt.Errorf("required_if expects 2 parameters, but had: %d", len(check.Parameters))
-		}
-		c := &conditionalRequiredCheck{
-			otherField: check.Parameters[0],
-			expected:   check.Parameters[1],
-			checkCurrent: func(current interface{}, condition bool) (bool, error) {
-				if !condition {
-					return true, nil
-				}
-				return !isEmpty(current), nil
-			},
-		}
-		return c.requiredIf, nil
+func (c *conditionalRequiredCheck) requiredWithoutAll(ctx context.Context, value interface{}) (bool, error) {
+	present, err := c.presentFields(ctx)
+	if err != nil {
+		return false, err
 	}
+	return c.checkCurrent(value, present == 0)
 }
 
-func NewRequiredUnless() func(field *Field, check *Check) (IsValid, error) {
-	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) != 2 {
-			return nil, fmt.Errorf("required_unless expects 2 parameters, but had: %d", len(check.Parameters))
-		}
-		c := &conditionalRequiredCheck{
-			otherField: check.Parameters[0],
-			expected:   check.Parameters[1],
-			checkCurrent: func(current interface{}, condition bool) (bool, error) {
-				if !condition {
-					return true, nil
-				}
-				return !isEmpty(current), nil
-			},
-		}
-		return c.requiredUnless, nil
+// requireCurrent requires current value when condition is met
+func requireCurrent(current interface{}, condition bool) (bool, error) {
+	if !condition {
+		return true, nil
 	}
+	return !isEmpty(current), nil
+}
+
+// excludeCurrent requires current value to be empty when condition is met
+func excludeCurrent(current interface{}, condition bool) (bool, error) {
+	if !condition {
+		return true, nil
+	}
+	return isEmpty(current), nil
+}
+
+// NewRequiredIf creates check requiring field when all other field conditions match, i.e. required_if(Type,a|b,Country,US)
+func NewRequiredIf() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldConditionCheck(requireCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredIf
+	})
+}
+
+// NewRequiredUnless creates check requiring field unless all other field conditions match
+func NewRequiredUnless() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldConditionCheck(requireCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredUnless
+	})
+}
+
+// NewExcludedIf creates check requiring empty field when all other field conditions match
+func NewExcludedIf() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldConditionCheck(excludeCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredIf
+	})
 }
 
+// NewExcludedUnless creates check requiring empty field unless all other field conditions match
+func NewExcludedUnless() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldConditionCheck(excludeCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredUnless
+	})
+}
+
+// NewRequiredWith creates check requiring field when any of other fields is present
 func NewRequiredWith() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldPresenceCheck(requireCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredWith
+	})
+}
+
+// NewRequiredWithAll creates check requiring field when all other fields are present
+func NewRequiredWithAll() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldPresenceCheck(requireCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredWithAll
+	})
+}
+
+// NewRequiredWithout creates check requiring field when any of other fields is empty
+func NewRequiredWithout() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldPresenceCheck(requireCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredWithout
+	})
+}
+
+// NewRequiredWithoutAll creates check requiring field when all other fields are empty
+func NewRequiredWithoutAll() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldPresenceCheck(requireCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredWithoutAll
+	})
+}
+
+// NewExcludedWith creates check requiring empty field when any of other fields is present
+func NewExcludedWith() func(field *Field, check *Check) (IsValid, error) {
+	return newFieldPresenceCheck(excludeCurrent, func(c *conditionalRequiredCheck) IsValid {
+		return c.requiredWith
+	})
+}
+
+// newFieldConditionCheck creates check with OtherField,values pairs parameters, values are separated by |
+func newFieldConditionCheck(checkCurrent func(current interface{}, condition bool) (bool, error), factory func(c *conditionalRequiredCheck) IsValid) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) == 0 {
-			return nil, fmt.Errorf("required_with expects at least 1 parameter")
-		}
-		c := &conditionalRequiredCheck{
-			otherFields: check.Parameters,
-			checkCurrent: func(current interface{}, condition bool) (bool, error) {
-				if !condition {
-					return true, nil
-				}
-				return !isEmpty(current), nil
-			},
+		if err := expectParameters(check, 2, -1); err != nil {
+			return nil, err
+		}
+		if len(check.Parameters)%2 != 0 {
+			return nil, NewInvalidParameterError(check, strings.Join(check.Parameters, ","), "expects OtherField,value pairs", nil)
+		}
+		c := &conditionalRequiredCheck{checkCurrent: checkCurrent}
+		for i := 0; i < len(check.Parameters); i += 2 {
+			otherField, err := newFieldRef(field.owner, check, check.Parameters[i])
+			if err != nil {
+				return nil, err
+			}
+			c.conditions = append(c.conditions, &fieldCondition{field: otherField, expected: strings.Split(check.Parameters[i+1], "|")})
 		}
-		return c.requiredWith, nil
+		return factory(c), nil
 	}
 }
 
-func NewRequiredWithout() func(field *Field, check *Check) (IsValid, error) {
+// newFieldPresenceCheck creates check with other fields parameters
+func newFieldPresenceCheck(checkCurrent func(current interface{}, condition bool) (bool, error), factory func(c *conditionalRequiredCheck) IsValid) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		if len(check.Parameters) == 0 {
-			return nil, fmt.Errorf("required_without expects at least 1 parameter")
-		}
-		c := &conditionalRequiredCheck{
-			otherFields: check.Parameters,
-			checkCurrent: func(current interface{}, condition bool) (bool, error) {
-				if !condition {
-					return true, nil
-				}
-				return !isEmpty(current), nil
-			},
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
 		}
-		return c.requiredWithout, nil
+		otherFields, err := newFieldRefs(field.owner, check, check.Parameters)
+		if err != nil {
+			return nil, err
+		}
+		return factory(&conditionalRequiredCheck{otherFields: otherFields, checkCurrent: checkCurrent}), nil
 	}
 }
 
@@ -508,19 +566,15 @@ func typeKinds(field *Field) (reflect.Kind, reflect.Kind) {
 
 func newTimeRelativeCheck(name string, predicate func(actual, now time.Time) bool) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		kind, elemKind := typeKinds(field)
-		supported := false
-		switch kind {
-		case reflect.String, reflect.Struct:
-			supported = true
-		case reflect.Slice:
-			supported = elemKind == reflect.String
+		params, layouts := splitTimeLayout(check.Parameters)
+		if len(params) > 0 {
+			return nil, NewInvalidParameterError(check, strings.Join(params, ","), "expects only layout= parameter", nil)
 		}
-		if !supported {
-			return nil, fmt.Errorf("unsupported %s type: %s", name, field.Type.String())
+		if !isTimeField(field) {
+			return nil, NewUnsupportedTypeError(field, check)
 		}
 		return func(ctx context.Context, value interface{}) (bool, error) {
-			actual, ok := toTimeValue(value)
+			actual, ok := toTimeValue(value, layouts)
 			if !ok {
 				return false, nil
 			}
@@ -529,8 +583,15 @@ func newTimeRelativeCheck(name string, predicate func(actual, now time.Time) boo
 	}
 }
 
-func isWholeNumber(value float64) bool {
-	return math.Trunc(value) == value
+func isTimeField(field *Field) bool {
+	kind, elemKind := typeKinds(field)
+	switch kind {
+	case reflect.String, reflect.Struct:
+		return true
+	case reflect.Slice:
+		return elemKind == reflect.String
+	}
+	return false
 }
 
 func numericValue(value interface{}) (float64, bool) {
@@ -594,7 +655,8 @@ func asStringValue(value interface{}) (string, bool) {
 	return rv.String(), true
 }
 
-func toTimeValue(value interface{}) (time.Time, bool) {
+// toTimeValue returns time.Time value or date string parsed with supplied layouts, defaultTimeLayouts are used when layouts are empty
+func toTimeValue(value interface{}, layouts []string) (time.Time, bool) {
 	if value == nil {
 		return time.Time{}, false
 	}
@@ -615,42 +677,19 @@ func toTimeValue(value interface{}) (time.Time, bool) {
 	if actual == "" {
 		return time.Time{}, false
 	}
-	formats := []string{time.RFC3339Nano, time.RFC3339, "2006-01-02"}
-	for _, layout := range formats {
-		if parsed, err := time.Parse(layout, actual); err == nil {
-			return parsed, true
-		}
-	}
-	return time.Time{}, false
+	return parseTime(actual, layouts)
 }
 
-func lookupOtherField(ctx context.Context, field string) (interface{}, error) {
-	session, ok := ctx.Value(SessionKey).(*Session)
-	if !ok || session == nil {
-		return nil, fmt.Errorf("validation session was not available")
-	}
-	parent := session.ParentValue
-	if parent == nil {
-		return nil, fmt.Errorf("validation parent value was not available")
+func parseTime(literal string, layouts []string) (time.Time, bool) {
+	if len(layouts) == 0 {
+		layouts = defaultTimeLayouts
 	}
-	rv := reflect.ValueOf(parent)
-	for rv.Kind() == reflect.Ptr {
-		if rv.IsNil() {
-			return nil, nil
+	for _, layout := range layouts {
+		if parsed, err := time.Parse(layout, literal); err == nil {
+			return parsed, true
 		}
-		rv = rv.Elem()
-	}
-	if rv.Kind() != reflect.Struct {
-		return nil, fmt.Errorf("eqfield family expects struct parent, but had: %T", parent)
-	}
-	fieldValue := rv.FieldByName(field)
-	if !fieldValue.IsValid() {
-		return nil, fmt.Errorf("field %q was not found on %T", field, parent)
-	}
-	if !fieldValue.CanInterface() {
-		return nil, fmt.Errorf("field %q is not accessible on %T", field, parent)
 	}
-	return fieldValue.Interface(), nil
+	return time.Time{}, false
 }
 
 func derefReflectValue(value interface{}) (reflect.Value, bool) {
@@ -673,30 +712,36 @@ func equalValues(left, right interface{}) bool {
 	if lnil || rnil {
 		return lnil && rnil
 	}
-	if ln, ok := numericValue(left); ok {
-		if rn, ok := numericValue(right); ok {
-			return ln == rn
+	if param, ok := newNumericValueParam(right); ok {
+		if cmp, ok := param.compare(left); ok {
+			return cmp == 0
 		}
 	}
 	return reflect.DeepEqual(lv.Interface(), rv.Interface())
 }
 
+// errNotComparable reports numeric values without exact order, i.e. NaN
+var errNotComparable = errors.New("value is not comparable")
+
+// compareValues compares numbers (exactly, via big.Rat), strings and times
 func compareValues(left, right interface{}) (int, error) {
 	lv, lnil := derefReflectValue(left)
 	rv, rnil := derefReflectValue(right)
 	if lnil || rnil {
 		return 0, fmt.Errorf("cannot compare nil value")
 	}
-	if ln, ok := numericValue(left); ok {
-		if rn, ok := numericValue(right); ok {
-			if ln > rn {
-				return 1, nil
-			}
-			if ln < rn {
-				return -1, nil
-			}
-			return 0, nil
+	if param, ok := newNumericValueParam(right); ok {
+		if cmp, ok := param.compare(left); ok {
+			return cmp, nil
+		}
+	}
+	if isNumericKind(lv.Kind()) && isNumericKind(rv.Kind()) { //NaN or infinity
+		ln, _ := numericValue(left)
+		rn, _ := numericValue(right)
+		if math.IsNaN(ln) || math.IsNaN(rn) {
+			return 0, errNotComparable
 		}
+		return compareFloat64(ln, rn), nil
 	}
 	if lv.Kind() == reflect.String && rv.Kind() == reflect.String {
 		ls, rs := lv.String(), rv.String()
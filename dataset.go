@@ -0,0 +1,45 @@
+package govalidator
+
+import (
+	_ "embed"
+	"strings"
+)
+
+var (
+	//go:embed data/iso3166.csv
+	iso3166Dataset string
+	//go:embed data/iso4217.txt
+	iso4217Dataset string
+	//go:embed data/iso639_1.txt
+	iso639Dataset string
+)
+
+var (
+	iso3166Alpha2, iso3166Alpha3, iso3166Numeric = countryCodeSets(iso3166Dataset)
+	iso4217Codes                                 = codeSet(iso4217Dataset)
+	iso639Alpha2                                 = codeSet(iso639Dataset)
+)
+
+// countryCodeSets returns alpha-2, alpha-3 and numeric country code sets of alpha2,alpha3,numeric CSV dataset
+func countryCodeSets(dataset string) (map[string]bool, map[string]bool, map[string]bool) {
+	alpha2, alpha3, numeric := map[string]bool{}, map[string]bool{}, map[string]bool{}
+	for i, line := range strings.Split(strings.TrimSpace(dataset), "\n") {
+		columns := strings.Split(strings.TrimSpace(line), ",")
+		if i == 0 || len(columns) != 3 {
+			continue
+		}
+		alpha2[columns[0]] = true
+		alpha3[columns[1]] = true
+		numeric[columns[2]] = true
+	}
+	return alpha2, alpha3, numeric
+}
+
+// codeSet returns set of white space separated codes
+func codeSet(dataset string) map[string]bool {
+	ret := map[string]bool{}
+	for _, code := range strings.Fields(dataset) {
+		ret[code] = true
+	}
+	return ret
+}
@@ -0,0 +1,219 @@
+package govalidator
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxDecimalLength limits length and exponent of decimal literals, exact arithmetic cost grows with number of digits
+const maxDecimalLength = 1000
+
+var decimalStringRegex = regexp.MustCompile("^[-+]?(?:[0-9]+(?:\\.[0-9]*)?|\\.[0-9]+)(?:[eE][-+]?[0-9]+)?$")
+
+type decimalCheck struct {
+	step      *big.Rat
+	base      *big.Rat
+	precision int
+	scale     int
+}
+
+func (d *decimalCheck) multipleOf(ctx context.Context, value interface{}) (bool, error) {
+	actual, ok := decimalValue(value)
+	if !ok {
+		return false, nil
+	}
+	if d.base != nil {
+		actual = new(big.Rat).Sub(actual, d.base)
+	}
+	return new(big.Rat).Quo(actual, d.step).IsInt(), nil
+}
+
+func (d *decimalCheck) decimal(ctx context.Context, value interface{}) (bool, error) {
+	actual, ok := decimalValue(value)
+	if !ok {
+		return false, nil
+	}
+	scale := decimalScale(actual)
+	if scale == -1 || scale > d.scale {
+		return false, nil
+	}
+	return integerDigits(actual) <= d.precision-d.scale, nil
+}
+
+// NewMultipleOf creates multiple of check, i.e. multipleof(0.25)
+func NewMultipleOf() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		step, err := newStepParam(check, check.Parameters[0])
+		if err != nil {
+			return nil, err
+		}
+		ret := &decimalCheck{step: step}
+		return newDecimalValueCheck(field, check, ret.multipleOf)
+	}
+}
+
+// NewStep creates step check with optional base, i.e. step(5) or step(0.5,0.25)
+func NewStep() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 2); err != nil {
+			return nil, err
+		}
+		step, err := newStepParam(check, check.Parameters[0])
+		if err != nil {
+			return nil, err
+		}
+		ret := &decimalCheck{step: step}
+		if len(check.Parameters) == 2 {
+			base, err := newNumericParam(check.Parameters[1])
+			if err != nil {
+				return nil, NewInvalidParameterError(check, check.Parameters[1], "expected step base number", err)
+			}
+			ret.base = base.rat
+		}
+		return newDecimalValueCheck(field, check, ret.multipleOf)
+	}
+}
+
+// NewDecimal creates decimal(precision,scale) check, precision is total number of digits, scale is number of fractional digits
+func NewDecimal() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 2, 2); err != nil {
+			return nil, err
+		}
+		precision, err := strconv.Atoi(check.Parameters[0])
+		if err != nil || precision < 1 {
+			return nil, NewInvalidParameterError(check, check.Parameters[0], "expected positive precision", err)
+		}
+		scale, err := strconv.Atoi(check.Parameters[1])
+		if err != nil || scale < 0 || scale > precision {
+			return nil, NewInvalidParameterError(check, check.Parameters[1], "expected scale between 0 and precision", err)
+		}
+		ret := &decimalCheck{precision: precision, scale: scale}
+		return newDecimalValueCheck(field, check, ret.decimal)
+	}
+}
+
+func newStepParam(check *Check, literal string) (*big.Rat, error) {
+	param, err := newNumericParam(literal)
+	if err != nil {
+		return nil, NewInvalidParameterError(check, literal, "expected number", err)
+	}
+	if param.rat.Sign() <= 0 {
+		return nil, NewInvalidParameterError(check, literal, "expected positive number", nil)
+	}
+	return param.rat, nil
+}
+
+func newDecimalValueCheck(field *Field, check *Check, isValid IsValid) (IsValid, error) {
+	if isBigNumber(field.Type) {
+		return isValid, nil
+	}
+	kind, elemKind := typeKinds(field)
+	if kind == reflect.Slice {
+		kind = elemKind
+	}
+	if kind == reflect.String || isNumericKind(kind) {
+		return isValid, nil
+	}
+	return nil, NewUnsupportedTypeError(field, check)
+}
+
+// decimalValue returns exact value of number or numeric string, floats are taken by their shortest decimal representation
+func decimalValue(value interface{}) (*big.Rat, bool) {
+	switch actual := value.(type) {
+	case *big.Int:
+		if actual == nil {
+			return nil, false
+		}
+		return new(big.Rat).SetInt(actual), true
+	case big.Int:
+		return new(big.Rat).SetInt(&actual), true
+	case *big.Float:
+		if actual == nil || actual.IsInf() {
+			return nil, false
+		}
+		return parseDecimal(actual.Text('g', -1))
+	case big.Float:
+		if actual.IsInf() {
+			return nil, false
+		}
+		return parseDecimal(actual.Text('g', -1))
+	case *big.Rat:
+		if actual == nil {
+			return nil, false
+		}
+		return actual, true
+	case big.Rat:
+		return &actual, true
+	}
+	rv, isNil := derefReflectValue(value)
+	if isNil {
+		return nil, false
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(rv.Uint())), true
+	case reflect.Float32:
+		return parseDecimal(strconv.FormatFloat(rv.Float(), 'g', -1, 32))
+	case reflect.Float64:
+		return parseDecimal(strconv.FormatFloat(rv.Float(), 'g', -1, 64))
+	case reflect.String:
+		return parseDecimal(strings.TrimSpace(rv.String()))
+	}
+	return nil, false
+}
+
+// parseDecimal parses decimal literal, literals longer than maxDecimalLength or with exponent beyond it are rejected
+func parseDecimal(literal string) (*big.Rat, bool) {
+	if len(literal) > maxDecimalLength || !decimalStringRegex.MatchString(literal) {
+		return nil, false
+	}
+	if index := strings.IndexAny(literal, "eE"); index != -1 {
+		exponent, err := strconv.Atoi(literal[index+1:])
+		if err != nil || exponent > maxDecimalLength || exponent < -maxDecimalLength {
+			return nil, false
+		}
+	}
+	return new(big.Rat).SetString(literal)
+}
+
+// decimalScale returns number of fractional decimal digits needed to represent value, or -1 if decimal expansion is infinite
+func decimalScale(value *big.Rat) int {
+	denom := new(big.Int).Set(value.Denom())
+	twos, fives := int(denom.TrailingZeroBits()), 0
+	denom.Rsh(denom, uint(twos))
+	remainder := new(big.Int)
+	for {
+		quotient, mod := new(big.Int).QuoRem(denom, big.NewInt(5), remainder)
+		if mod.Sign() != 0 {
+			break
+		}
+		denom = quotient
+		fives++
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return -1
+	}
+	if twos > fives {
+		return twos
+	}
+	return fives
+}
+
+// integerDigits returns number of digits of value integer part, zero integer part has no digits
+func integerDigits(value *big.Rat) int {
+	integer := new(big.Int).Quo(value.Num(), value.Denom())
+	if integer.Sign() == 0 {
+		return 0
+	}
+	return len(integer.Abs(integer).String())
+}
@@ -0,0 +1,108 @@
+package govalidator
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func isDuration(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t == durationType
+}
+
+// isDurationLiteral returns true for literal with time unit, i.e. 1s, 100ms, 1h30m
+func isDurationLiteral(literal string) bool {
+	literal = strings.TrimSpace(literal)
+	if _, err := strconv.ParseFloat(literal, 64); err == nil {
+		return false
+	}
+	_, err := time.ParseDuration(literal)
+	return err == nil
+}
+
+// newDurationParam parses duration literal or plain number of nanoseconds
+func newDurationParam(literal string) (*numericParam, error) {
+	if isDurationLiteral(literal) {
+		duration, _ := time.ParseDuration(strings.TrimSpace(literal))
+		ret, err := newNumericParam(strconv.FormatInt(int64(duration), 10))
+		if err != nil {
+			return nil, err
+		}
+		ret.literal = literal
+		return ret, nil
+	}
+	return newNumericParam(literal)
+}
+
+// newNumericParams parses numeric check parameters, time.Duration fields and string fields with duration literal parameters use duration parameters,
+// returned flag is true when string values have to be parsed as durations
+func newNumericParams(field *Field, check *Check) ([]*numericParam, bool, error) {
+	asDuration := isDuration(field.Type)
+	durationString := false
+	if !asDuration && !field.isNumberString() && isStringField(field) {
+		for _, literal := range check.Parameters {
+			if isDurationLiteral(literal) {
+				durationString = true
+			}
+		}
+	}
+	var params []*numericParam
+	for _, literal := range check.Parameters {
+		if asDuration || durationString {
+			param, err := newDurationParam(literal)
+			if err != nil {
+				return nil, false, NewInvalidParameterError(check, literal, "expected duration", err)
+			}
+			params = append(params, param)
+			continue
+		}
+		param, err := newNumericParam(literal)
+		if err != nil {
+			return nil, false, NewInvalidParameterError(check, literal, "expected number", err)
+		}
+		params = append(params, param)
+	}
+	return params, durationString, nil
+}
+
+func isStringField(field *Field) bool {
+	kind, elemKind := typeKinds(field)
+	return kind == reflect.String || (kind == reflect.Slice && elemKind == reflect.String)
+}
+
+// durationStringCheck adapts numeric check to duration string value
+func durationStringCheck(isValid IsValid) IsValid {
+	return func(ctx context.Context, value interface{}) (bool, error) {
+		text, ok := asStringValue(value)
+		if !ok {
+			return false, nil
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(text))
+		if err != nil {
+			return false, nil
+		}
+		return isValid(ctx, duration)
+	}
+}
+
+// messageParams returns check parameters for messages, plain nanosecond parameters of time.Duration value are formatted as durations
+func messageParams(value interface{}, params []string) []string {
+	if _, ok := value.(time.Duration); !ok {
+		return params
+	}
+	var ret = make([]string, len(params))
+	for i, param := range params {
+		ret[i] = param
+		if nanos, err := strconv.ParseInt(strings.TrimSpace(param), 10, 64); err == nil {
+			ret[i] = time.Duration(nanos).String()
+		}
+	}
+	return ret
+}
@@ -0,0 +1,195 @@
+package govalidator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	emailStrict     = "strict"
+	emailNoPlus     = "noplus"
+	emailMX         = "mx"
+	emailDomainOpt  = "domain="
+	maxEmailLength  = 254
+	maxLocalLength  = 64
+	maxDomainLength = 253
+	maxLabelLength  = 63
+)
+
+type (
+	//DomainResolver resolves whether email domain accepts mail, used by email(mx) check
+	DomainResolver interface {
+		HasMX(ctx context.Context, domain string) (bool, error)
+	}
+
+	//netDomainResolver resolves MX records with net.DefaultResolver
+	netDomainResolver struct{}
+
+	emailCheck struct {
+		strict  bool
+		noPlus  bool
+		mx      bool
+		domains map[string]bool
+	}
+)
+
+// HasMX returns true if domain has MX records, not found domain is reported as false
+func (r *netDomainResolver) HasMX(ctx context.Context, domain string) (bool, error) {
+	records, err := net.DefaultResolver.LookupMX(ctx, domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(records) > 0, nil
+}
+
+func (e *emailCheck) isValid(ctx context.Context, actual string) (bool, error) {
+	if len(actual) > maxEmailLength {
+		return false, nil
+	}
+	//only bare addr-spec is accepted, display names, angle brackets and comments are rejected
+	address, err := mail.ParseAddress(actual)
+	if err != nil || address.Name != "" || address.String() != "<"+actual+">" {
+		return false, nil
+	}
+	at := strings.LastIndex(actual, "@")
+	local, domain := actual[:at], actual[at+1:]
+	if len(local) > maxLocalLength {
+		return false, nil
+	}
+	if e.strict && !isDotAtom(local) {
+		return false, nil
+	}
+	if e.noPlus && strings.Contains(local, "+") {
+		return false, nil
+	}
+	asciiDomain, ok := toASCIIDomain(domain)
+	if !ok || !isEmailDomain(asciiDomain, e.strict) {
+		return false, nil
+	}
+	if len(e.domains) > 0 && !e.domains[asciiDomain] {
+		return false, nil
+	}
+	if e.mx {
+		return sessionDomainResolver(ctx).HasMX(ctx, asciiDomain)
+	}
+	return true, nil
+}
+
+// NewEmail creates RFC 5322 email address check with internationalized domain support, i.e. email, email(strict,noplus), email(domain=acme.com|acme.org) or email(mx)
+func NewEmail() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		ret := &emailCheck{}
+		for _, param := range check.Parameters {
+			option := strings.TrimSpace(param)
+			switch lower := strings.ToLower(option); {
+			case lower == emailStrict:
+				ret.strict = true
+			case lower == emailNoPlus:
+				ret.noPlus = true
+			case lower == emailMX:
+				ret.mx = true
+			case strings.HasPrefix(lower, emailDomainOpt):
+				if ret.domains == nil {
+					ret.domains = map[string]bool{}
+				}
+				for _, domain := range strings.Split(option[len(emailDomainOpt):], "|") {
+					asciiDomain, ok := toASCIIDomain(strings.TrimSpace(domain))
+					if !ok || !isEmailDomain(asciiDomain, false) {
+						return nil, NewInvalidParameterError(check, param, "expected domain", nil)
+					}
+					ret.domains[asciiDomain] = true
+				}
+			default:
+				return nil, NewInvalidParameterError(check, param, "expected strict, noplus, mx or domain= option", nil)
+			}
+		}
+		return newStringValueCheck(field, check, ret.isValid)
+	}
+}
+
+// WithDomainResolver creates with domain resolver option used by email(mx) check
+func WithDomainResolver(resolver DomainResolver) Option {
+	return func(c *Options) {
+		c.DomainResolver = resolver
+	}
+}
+
+func sessionDomainResolver(ctx context.Context) DomainResolver {
+	if session, ok := ctx.Value(SessionKey).(*Session); ok && session != nil && session.resolver != nil {
+		return session.resolver
+	}
+	return &netDomainResolver{}
+}
+
+// isDotAtom returns true for ASCII dot-atom local part, quoted strings are not allowed
+func isDotAtom(local string) bool {
+	for _, atom := range strings.Split(local, ".") {
+		if atom == "" {
+			return false
+		}
+		for i := 0; i < len(atom); i++ {
+			c := atom[i]
+			if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) != -1 {
+				continue
+			}
+			return false
+		}
+	}
+	return true
+}
+
+// isEmailDomain returns true for ASCII host name with at least two labels, strict mode requires alphabetic or punycode top level domain
+func isEmailDomain(domain string, strict bool) bool {
+	if len(domain) > maxDomainLength {
+		return false
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > maxLabelLength || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	if strict {
+		tld := labels[len(labels)-1]
+		if strings.HasPrefix(tld, "xn--") {
+			return true
+		}
+		if len(tld) < 2 {
+			return false
+		}
+		for i := 0; i < len(tld); i++ {
+			if tld[i] < 'a' || tld[i] > 'z' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// toASCIIDomain maps domain with UTS #46 lookup profile and encodes its non ASCII labels with punycode, invalid labels are rejected
+func toASCIIDomain(domain string) (string, bool) {
+	if !utf8.ValidString(domain) {
+		return "", false
+	}
+	ret, err := idna.Lookup.ToASCII(domain)
+	return ret, err == nil
+}
@@ -0,0 +1,164 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testDomainResolver map[string]bool
+
+func (r testDomainResolver) HasMX(ctx context.Context, domain string) (bool, error) {
+	if domain == "timeout.com" {
+		return false, fmt.Errorf("lookup %v: timeout", domain)
+	}
+	return r[domain], nil
+}
+
+func TestService_Validate_Email(t *testing.T) {
+	resolver := testDomainResolver{"acme.com": true, "xn--mnchen-3ya.de": true}
+	var testCases = []struct {
+		description string
+		input       interface{}
+		options     []Option
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "valid addresses",
+			input: struct {
+				Plain          string   `validate:"email"`
+				Plus           *string  `validate:"email"`
+				Quoted         string   `validate:"email"`
+				International  string   `validate:"email"`
+				UnicodeLocal   string   `validate:"email"`
+				AdditionalList []string `validate:"email"`
+			}{
+				Plain:          "john.doe@example.com",
+				Plus:           stringPtr("john+news@example.com"),
+				Quoted:         `"john doe"@example.com`,
+				International:  "info@münchen.de",
+				UnicodeLocal:   "用户@例子.广告",
+				AdditionalList: []string{"a@b.co"},
+			},
+		},
+		{
+			description: "invalid addresses",
+			input: struct {
+				Missing     string `validate:"email"`
+				DisplayName string `validate:"email"`
+				DoubleDot   string `validate:"email"`
+				NoTLD       string `validate:"email"`
+				Hyphen      string `validate:"email"`
+				LongLocal   string `validate:"email"`
+				Disallowed  string `validate:"email"`
+				BadPunycode string `validate:"email"`
+			}{
+				Missing:     "abc",
+				DisplayName: "John <john@example.com>",
+				DoubleDot:   "john..doe@example.com",
+				NoTLD:       "john@localhost",
+				Hyphen:      "john@-example.com",
+				LongLocal:   fmt.Sprintf("%065d@example.com", 1),
+				Disallowed:  "john@exa\ufffdmple.com",
+				BadPunycode: "john@xn--zz.com",
+			},
+			expectPaths: []string{"Missing", "DisplayName", "DoubleDot", "NoTLD", "Hyphen", "LongLocal", "Disallowed", "BadPunycode"},
+		},
+		{
+			description: "strict and noplus",
+			input: struct {
+				Quoted  string `validate:"email(strict)"`
+				Unicode string `validate:"email(strict)"`
+				Numeric string `validate:"email(strict)"`
+				Plus    string `validate:"email(noplus)"`
+				Valid   string `validate:"email(strict,noplus)"`
+			}{
+				Quoted:  `"john doe"@example.com`,
+				Unicode: "用户@example.com",
+				Numeric: "john@example.123",
+				Plus:    "john+news@example.com",
+				Valid:   "john@münchen.de",
+			},
+			expectPaths: []string{"Quoted", "Unicode", "Numeric", "Plus"},
+		},
+		{
+			description: "domain allowlist",
+			input: struct {
+				Work  string `validate:"email(domain=acme.com|münchen.de)"`
+				IDN   string `validate:"email(domain=acme.com|münchen.de)"`
+				Other string `validate:"email(domain=acme.com)"`
+			}{Work: "john@ACME.com", IDN: "john@xn--mnchen-3ya.de", Other: "john@example.com"},
+			expectPaths: []string{"Other"},
+		},
+		{
+			description: "mx with injected resolver",
+			input: struct {
+				Known   string `validate:"email(mx)"`
+				IDN     string `validate:"email(mx)"`
+				Unknown string `validate:"email(mx)"`
+			}{Known: "john@acme.com", IDN: "info@münchen.de", Unknown: "john@example.com"},
+			options:     []Option{WithDomainResolver(resolver)},
+			expectPaths: []string{"Unknown"},
+		},
+		{
+			description: "mx resolver error",
+			input: struct {
+				Email string `validate:"email(mx)"`
+			}{Email: "john@timeout.com"},
+			options:   []Option{WithDomainResolver(resolver)},
+			expectErr: true,
+		},
+		{
+			description: "unknown option",
+			input: struct {
+				Email string `validate:"email(loose)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input, testCase.options...)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func TestToASCIIDomain(t *testing.T) {
+	var testCases = []struct {
+		input     string
+		expect    string
+		expectErr bool
+	}{
+		{input: "Example.COM", expect: "example.com"},
+		{input: "münchen.de", expect: "xn--mnchen-3ya.de"},
+		{input: "bücher.example", expect: "xn--bcher-kva.example"},
+		{input: "例え.テスト", expect: "xn--r8jz45g.xn--zckzah"},
+		{input: "ｅｘａｍｐｌｅ．com", expect: "example.com"},
+		{input: "exa\ufffdmple.com", expectErr: true},
+		{input: "xn--zz.com", expectErr: true},
+		{input: "a_b.com", expectErr: true},
+	}
+	for _, testCase := range testCases {
+		actual, ok := toASCIIDomain(testCase.input)
+		if testCase.expectErr {
+			assert.False(t, ok, testCase.input)
+			continue
+		}
+		assert.True(t, ok, testCase.input)
+		assert.EqualValues(t, testCase.expect, actual, testCase.input)
+	}
+}
@@ -0,0 +1,178 @@
+package govalidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+)
+
+const jwtAlgOpt = "alg="
+
+// base64Encodings maps base64 check option to accepted encodings, padding and unused bits are checked strictly
+var base64Encodings = map[string][]*base64.Encoding{
+	"std":    {base64.StdEncoding.Strict()},
+	"url":    {base64.URLEncoding.Strict()},
+	"rawstd": {base64.RawStdEncoding.Strict()},
+	"rawurl": {base64.RawURLEncoding.Strict()},
+	"raw":    {base64.RawStdEncoding.Strict(), base64.RawURLEncoding.Strict()},
+}
+
+// anyBase64Encodings lists encodings accepted by base64len check
+var anyBase64Encodings = []*base64.Encoding{base64.StdEncoding.Strict(), base64.URLEncoding.Strict(), base64.RawStdEncoding.Strict(), base64.RawURLEncoding.Strict()}
+
+type jwtCheck struct {
+	algorithms map[string]bool
+}
+
+func (j *jwtCheck) isValid(ctx context.Context, actual []byte) (bool, error) {
+	parts := bytes.Split(actual, []byte("."))
+	if len(parts) != 3 {
+		return false, nil
+	}
+	header := struct {
+		Alg *string `json:"alg"`
+	}{}
+	if !decodeJWTPart(parts[0], &header) || header.Alg == nil || *header.Alg == "" {
+		return false, nil
+	}
+	var claims map[string]interface{}
+	if !decodeJWTPart(parts[1], &claims) || claims == nil {
+		return false, nil
+	}
+	alg := *header.Alg
+	if j.algorithms == nil && alg == "none" {
+		return false, nil
+	}
+	if j.algorithms != nil && !j.algorithms[alg] {
+		return false, nil
+	}
+	if alg == "none" {
+		return len(parts[2]) == 0, nil
+	}
+	signature, ok := decodeBase64(parts[2], base64Encodings["rawurl"])
+	return ok && len(signature) > 0, nil
+}
+
+// NewUTF8 creates valid UTF-8 encoding check
+func NewUTF8() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		return newBytesValueCheck(field, check, func(ctx context.Context, actual []byte) (bool, error) {
+			return utf8.Valid(actual), nil
+		})
+	}
+}
+
+// NewHexLen creates hex encoded value check with decoded length in bytes, i.e. hexlen(32) for 64 hex digits or hexlen(16,64)
+func NewHexLen() func(field *Field, check *Check) (IsValid, error) {
+	return newDecodedLenCheck(func(actual []byte) ([]byte, bool) {
+		ret := make([]byte, hex.DecodedLen(len(actual)))
+		_, err := hex.Decode(ret, actual)
+		return ret, err == nil
+	})
+}
+
+// NewBase64Len creates base64 (standard or URL alphabet, padded or raw) encoded value check with decoded length in bytes, i.e. base64len(32) or base64len(1,1024)
+func NewBase64Len() func(field *Field, check *Check) (IsValid, error) {
+	return newDecodedLenCheck(func(actual []byte) ([]byte, bool) {
+		return decodeBase64(actual, anyBase64Encodings)
+	})
+}
+
+// NewBase64 creates non empty base64 encoded value check, encodings are std (default), url, rawstd, rawurl or raw (unpadded with either alphabet), i.e. base64(std|url)
+func NewBase64() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		var encodings []*base64.Encoding
+		for _, param := range check.Parameters {
+			for _, option := range strings.Split(param, "|") {
+				candidates, ok := base64Encodings[strings.ToLower(strings.TrimSpace(option))]
+				if !ok {
+					return nil, NewInvalidParameterError(check, param, "expected std, url, rawstd, rawurl or raw", nil)
+				}
+				encodings = append(encodings, candidates...)
+			}
+		}
+		if len(encodings) == 0 {
+			encodings = base64Encodings["std"]
+		}
+		return newBytesValueCheck(field, check, func(ctx context.Context, actual []byte) (bool, error) {
+			if len(actual) == 0 {
+				return false, nil
+			}
+			_, ok := decodeBase64(actual, encodings)
+			return ok, nil
+		})
+	}
+}
+
+// NewJWT creates JSON Web Token check that decodes JSON header with alg and JSON object claims, alg option restricts algorithms, i.e. jwt(alg=RS256|ES256);
+// signature is not verified, unsecured token (alg none) is accepted only when listed, i.e. jwt(alg=none), and has to have empty signature
+func NewJWT() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		ret := &jwtCheck{}
+		for _, param := range check.Parameters {
+			option := strings.TrimSpace(param)
+			if !strings.HasPrefix(strings.ToLower(option), jwtAlgOpt) {
+				return nil, NewInvalidParameterError(check, param, "expected alg= option", nil)
+			}
+			if ret.algorithms == nil {
+				ret.algorithms = map[string]bool{}
+			}
+			for _, alg := range strings.Split(option[len(jwtAlgOpt):], "|") {
+				if alg = strings.TrimSpace(alg); alg == "" {
+					return nil, NewInvalidParameterError(check, param, "expected algorithm name, i.e. RS256", nil)
+				}
+				ret.algorithms[alg] = true
+			}
+		}
+		return newBytesValueCheck(field, check, ret.isValid)
+	}
+}
+
+// newDecodedLenCheck creates encoded value check with decoded length parameter, single parameter defines exact length, two parameters define range
+func newDecodedLenCheck(decode func(actual []byte) ([]byte, bool)) func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 2); err != nil {
+			return nil, err
+		}
+		min, err := parseTextLength(check, check.Parameters[0])
+		if err != nil {
+			return nil, err
+		}
+		max := min
+		if len(check.Parameters) == 2 {
+			if max, err = parseTextLength(check, check.Parameters[1]); err != nil {
+				return nil, err
+			}
+			if min > max {
+				return nil, NewInvalidParameterError(check, check.Parameters[0], "expected min not greater than max", nil)
+			}
+		}
+		return newBytesValueCheck(field, check, func(ctx context.Context, actual []byte) (bool, error) {
+			decoded, ok := decode(actual)
+			return ok && len(decoded) >= min && len(decoded) <= max, nil
+		})
+	}
+}
+
+// decodeBase64 decodes value with the first matching encoding, line breaks ignored by encoding/base64 are rejected
+func decodeBase64(actual []byte, encodings []*base64.Encoding) ([]byte, bool) {
+	if bytes.ContainsAny(actual, "\r\n") {
+		return nil, false
+	}
+	for _, encoding := range encodings {
+		ret := make([]byte, encoding.DecodedLen(len(actual)))
+		if n, err := encoding.Decode(ret, actual); err == nil {
+			return ret[:n], true
+		}
+	}
+	return nil, false
+}
+
+func decodeJWTPart(part []byte, target interface{}) bool {
+	decoded, ok := decodeBase64(part, base64Encodings["rawurl"])
+	return ok && json.Unmarshal(decoded, target) == nil
+}
@@ -0,0 +1,145 @@
+package govalidator
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_EncodingChecks(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "utf8",
+			input: struct {
+				Text  string   `validate:"utf8"`
+				Raw   []byte   `validate:"utf8"`
+				Lines []string `validate:"utf8"`
+			}{Text: "żółw", Raw: []byte{0xff, 0xfe}, Lines: []string{"ok", "\xc3\x28"}},
+			expectPaths: []string{"Raw", "Lines[1]"},
+		},
+		{
+			description: "hexlen",
+			input: struct {
+				Key    string   `validate:"hexlen(32)"`
+				Digest []byte   `validate:"hexlen(16,32)"`
+				Keys   []string `validate:"hexlen(4)"`
+			}{Key: strings.Repeat("aF", 32), Digest: []byte(strings.Repeat("0", 30)), Keys: []string{"deadbeef", "deadbee", "0xdeadbe", "deadbeefde"}},
+			expectPaths: []string{"Digest", "Keys[1]", "Keys[2]", "Keys[3]"},
+		},
+		{
+			description: "base64len",
+			input: struct {
+				Nonce  string   `validate:"base64len(16)"`
+				Secret []byte   `validate:"base64len(1,4)"`
+				Values []string `validate:"base64len(0,3)"`
+			}{Nonce: base64.RawURLEncoding.EncodeToString(make([]byte, 16)), Secret: []byte("AAAAAAAA"), Values: []string{"", "YWJj", "YWI=", "YQ", "Y", "YWJjZA=="}},
+			expectPaths: []string{"Secret", "Values[4]", "Values[5]"},
+		},
+		{
+			description: "base64",
+			input: struct {
+				Std    string   `validate:"base64"`
+				Raw    []byte   `validate:"base64(raw)"`
+				URL    *string  `validate:"base64(url)"`
+				Any    []string `validate:"base64(std|url)"`
+				Strict []string `validate:"base64"`
+			}{Std: "+/+/", Raw: []byte("_-_-YQ"), URL: stringPtr("-_-_YQ=="), Any: []string{"+/8=", "-_8=", "-_8"}, Strict: []string{"", "YQ", "YR==", "YW\nJj", "YWJj"}},
+			expectPaths: []string{"Any[2]", "Strict[0]", "Strict[1]", "Strict[2]", "Strict[3]"},
+		},
+		{
+			description: "empty base64",
+			input: struct {
+				Required string `validate:"base64"`
+				Optional string `validate:"omitempty,base64"`
+			}{},
+			expectPaths: []string{"Required"},
+		},
+		{
+			description: "jwt",
+			input: struct {
+				Token      string   `validate:"jwt"`
+				Bearer     []byte   `validate:"jwt(alg=RS256|ES256)"`
+				Restricted string   `validate:"jwt(alg=RS256)"`
+				Unsecured  string   `validate:"jwt"`
+				Allowed    string   `validate:"jwt(alg=none)"`
+				Invalid    []string `validate:"jwt"`
+			}{
+				Token:      testJWT(`{"alg":"HS256","typ":"JWT"}`, `{"sub":"1"}`, "sig"),
+				Bearer:     []byte(testJWT(`{"alg":"ES256"}`, `{"sub":"1"}`, "sig")),
+				Restricted: testJWT(`{"alg":"HS256"}`, `{"sub":"1"}`, "sig"),
+				Unsecured:  testJWT(`{"alg":"none"}`, `{"sub":"1"}`, ""),
+				Allowed:    testJWT(`{"alg":"none"}`, `{"sub":"1"}`, ""),
+				Invalid: []string{
+					testJWT(`{"typ":"JWT"}`, `{"sub":"1"}`, "sig"),
+					testJWT(`{"alg":"HS256"}`, `[1]`, "sig"),
+					testJWT(`{"alg":"HS256"}`, `{"sub":"1"}`, ""),
+					testJWT(`{"alg":"none"}`, `{"sub":"1"}`, "sig"),
+					testJWT(`not json`, `{"sub":"1"}`, "sig"),
+					"a.b",
+				},
+			},
+			expectPaths: []string{"Restricted", "Unsecured", "Invalid[0]", "Invalid[1]", "Invalid[2]", "Invalid[3]", "Invalid[4]", "Invalid[5]"},
+		},
+		{
+			description: "invalid base64 option",
+			input: struct {
+				Value string `validate:"base64(hex)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "invalid jwt option",
+			input: struct {
+				Value string `validate:"jwt(RS256)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "invalid length range",
+			input: struct {
+				Value string `validate:"hexlen(8,4)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Value []int `validate:"utf8"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func testJWT(header, claims, signature string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	ret := encode([]byte(header)) + "." + encode([]byte(claims)) + "."
+	if signature != "" {
+		ret += encode([]byte(signature))
+	}
+	return ret
+}
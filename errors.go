@@ -0,0 +1,158 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type (
+	//CheckError represents check configuration error, it is returned when checks can not be built for a struct field
+	CheckError struct {
+		Owner reflect.Type
+		Field string
+		Check string
+		Tag   string
+		Err   error
+	}
+
+	//UnknownCheckError represents unregistered check error
+	UnknownCheckError struct {
+		CheckError
+	}
+
+	//UnsupportedTypeError represents check that does not support field type
+	UnsupportedTypeError struct {
+		CheckError
+		Type reflect.Type
+	}
+
+	//InvalidParameterError represents invalid check parameter error
+	InvalidParameterError struct {
+		CheckError
+		Parameter string
+		Reason    string
+	}
+
+	checkContextSetter interface {
+		setContext(owner reflect.Type, field, tag string)
+	}
+)
+
+func (e *CheckError) setContext(owner reflect.Type, field, tag string) {
+	e.Owner = owner
+	e.Field = field
+	e.Tag = tag
+}
+
+func (e *CheckError) location() string {
+	builder := new(strings.Builder)
+	builder.WriteString("check '")
+	builder.WriteString(e.Check)
+	builder.WriteString("'")
+	if e.Field != "" {
+		builder.WriteString(" on ")
+		if e.Owner != nil {
+			builder.WriteString(e.Owner.String())
+			builder.WriteString(".")
+		}
+		builder.WriteString(e.Field)
+	}
+	if e.Tag != "" {
+		builder.WriteString(" (validate:\"")
+		builder.WriteString(e.Tag)
+		builder.WriteString("\")")
+	}
+	return builder.String()
+}
+
+func (e *CheckError) Error() string {
+	if e.Err == nil {
+		return "invalid " + e.location()
+	}
+	return "invalid " + e.location() + ": " + e.Err.Error()
+}
+
+func (e *CheckError) Unwrap() error {
+	return e.Err
+}
+
+func (e *UnknownCheckError) Error() string {
+	return "unknown " + e.location()
+}
+
+func (e *UnsupportedTypeError) Error() string {
+	typeName := "<nil>"
+	if e.Type != nil {
+		typeName = e.Type.String()
+	}
+	return "unsupported type " + typeName + " for " + e.location()
+}
+
+func (e *InvalidParameterError) Error() string {
+	ret := "invalid parameter"
+	if e.Parameter != "" {
+		ret += fmt.Sprintf(" %q", e.Parameter)
+	}
+	ret += " for " + e.location()
+	if e.Reason != "" {
+		ret += ": " + e.Reason
+	}
+	if e.Err != nil {
+		ret += ": " + e.Err.Error()
+	}
+	return ret
+}
+
+//NewUnknownCheckError creates unknown check error
+func NewUnknownCheckError(check *Check) error {
+	return &UnknownCheckError{CheckError: CheckError{Check: check.Name}}
+}
+
+//NewUnsupportedTypeError creates unsupported field type error
+func NewUnsupportedTypeError(field *Field, check *Check) error {
+	ret := &UnsupportedTypeError{CheckError: CheckError{Check: check.Name}}
+	if field != nil && field.Field != nil {
+		ret.Field = field.Name
+		ret.Type = field.Type
+	}
+	return ret
+}
+
+//NewInvalidParameterError creates invalid check parameter error, err is optional cause
+func NewInvalidParameterError(check *Check, parameter string, reason string, err error) error {
+	return &InvalidParameterError{CheckError: CheckError{Check: check.Name, Err: err}, Parameter: parameter, Reason: reason}
+}
+
+//expectParameters returns invalid parameter error if check parameter count is out of [min, max] range, negative max means unbounded
+func expectParameters(check *Check, min, max int) error {
+	count := len(check.Parameters)
+	if count >= min && (max < 0 || count <= max) {
+		return nil
+	}
+	var expected string
+	switch {
+	case min == max:
+		expected = fmt.Sprintf("%d", min)
+	case max < 0:
+		expected = fmt.Sprintf("at least %d", min)
+	default:
+		expected = fmt.Sprintf("%d to %d", min, max)
+	}
+	noun := "parameters"
+	if min == max && min == 1 {
+		noun = "parameter"
+	}
+	return NewInvalidParameterError(check, strings.Join(check.Parameters, ","), fmt.Sprintf("expects %s %s, but had: %d", expected, noun, count), nil)
+}
+
+//withCheckContext decorates check configuration error with owner type, field and tag literal
+func withCheckContext(err error, owner reflect.Type, field *Field, check *Check, tag string) error {
+	var setter checkContextSetter
+	if errors.As(err, &setter) {
+		setter.setContext(owner, field.Name, tag)
+		return err
+	}
+	return &CheckError{Owner: owner, Field: field.Name, Check: check.Name, Tag: tag, Err: err}
+}
@@ -0,0 +1,93 @@
+package govalidator
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChecks_Errors(t *testing.T) {
+	type UnknownCheck struct {
+		Name string `validate:"required,nosuchcheck"`
+	}
+	type MissingParameter struct {
+		Value int `validate:"gt"`
+	}
+	type InvalidParameter struct {
+		Value string `validate:"min(abc)"`
+	}
+	type UnsupportedType struct {
+		Flag bool `validate:"gt(1)"`
+	}
+	type InvalidSeverity struct {
+		Value int `validate:"max(1),severity=fatal"`
+	}
+	type LeadingSeverity struct {
+		Value int `validate:"severity=warning,max(1)"`
+	}
+
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expect      interface{}
+		check       string
+		field       string
+		tag         string
+	}{
+		{description: "unknown check", input: UnknownCheck{}, expect: &UnknownCheckError{}, check: "nosuchcheck", field: "Name", tag: "required,nosuchcheck"},
+		{description: "missing parameter", input: MissingParameter{}, expect: &InvalidParameterError{}, check: "gt", field: "Value", tag: "gt"},
+		{description: "invalid parameter", input: InvalidParameter{}, expect: &InvalidParameterError{}, check: "min", field: "Value", tag: "min(abc)"},
+		{description: "unsupported type", input: UnsupportedType{}, expect: &UnsupportedTypeError{}, check: "gt", field: "Flag", tag: "gt(1)"},
+		{description: "invalid severity", input: InvalidSeverity{}, expect: &InvalidParameterError{}, check: "max", field: "Value", tag: "max(1),severity=fatal"},
+		{description: "severity without check", input: LeadingSeverity{}, expect: &UnknownCheckError{}, check: "", field: "Value", tag: "severity=warning,max(1)"},
+	}
+
+	for _, testCase := range testCases {
+		var err error
+		assert.NotPanics(t, func() {
+			_, err = New().Validate(context.Background(), testCase.input)
+		}, testCase.description)
+		if !assert.NotNil(t, err, testCase.description) {
+			continue
+		}
+		var checkErr *CheckError
+		switch testCase.expect.(type) {
+		case *UnknownCheckError:
+			var actual *UnknownCheckError
+			if assert.True(t, errors.As(err, &actual), testCase.description) {
+				checkErr = &actual.CheckError
+			}
+		case *UnsupportedTypeError:
+			var actual *UnsupportedTypeError
+			if assert.True(t, errors.As(err, &actual), testCase.description) {
+				checkErr = &actual.CheckError
+				assert.Equal(t, reflect.TypeOf(true), actual.Type, testCase.description)
+			}
+		case *InvalidParameterError:
+			var actual *InvalidParameterError
+			if assert.True(t, errors.As(err, &actual), testCase.description) {
+				checkErr = &actual.CheckError
+			}
+		}
+		if checkErr == nil {
+			continue
+		}
+		assert.Equal(t, reflect.TypeOf(testCase.input), checkErr.Owner, testCase.description)
+		assert.Equal(t, testCase.field, checkErr.Field, testCase.description)
+		assert.Equal(t, testCase.check, checkErr.Check, testCase.description)
+		assert.Equal(t, testCase.tag, checkErr.Tag, testCase.description)
+	}
+}
+
+func TestNewChecks_ErrorMessage(t *testing.T) {
+	type Record struct {
+		Value int `validate:"gt"`
+	}
+	_, err := NewChecks(reflect.TypeOf(Record{}))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, `invalid parameter for check 'gt' on govalidator.Record.Value (validate:"gt"): expects 1 parameter, but had: 0`, err.Error())
+	}
+}
@@ -0,0 +1,169 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/viant/xunsafe"
+)
+
+const (
+	rootAnchor   = "$root"
+	parentAnchor = "$parent"
+)
+
+const (
+	ownerRef = refAnchor(iota)
+	rootRef
+	parentRef
+)
+
+type (
+	refAnchor int
+
+	//fieldRef represents other field reference, i.e. Password, Address.Country, $root.Limits.Max or $parent.Max
+	fieldRef struct {
+		literal   string
+		anchor    refAnchor
+		path      []string
+		accessors sync.Map
+	}
+
+	//fieldAccessor represents field path compiled for a struct type
+	fieldAccessor struct {
+		fields []*xunsafe.Field
+	}
+)
+
+// Value returns referenced field value, wrapper types are unwrapped, nil is returned when an intermediate pointer is nil
+func (r *fieldRef) Value(ctx context.Context) (interface{}, error) {
+	session, ok := ctx.Value(SessionKey).(*Session)
+	if !ok || session == nil {
+		return nil, fmt.Errorf("validation session was not available")
+	}
+	var holder interface{}
+	switch r.anchor {
+	case rootRef:
+		holder = session.Root
+	case parentRef:
+		if count := len(session.parents); count > 0 {
+			holder = session.parents[count-1]
+		}
+	default:
+		holder = session.ParentValue
+	}
+	if holder == nil {
+		return nil, nil
+	}
+	accessor, err := r.accessor(reflect.TypeOf(holder))
+	if err != nil {
+		return nil, err
+	}
+	return unwrapValue(accessor.value(holder))
+}
+
+func (r *fieldRef) accessor(t reflect.Type) (*fieldAccessor, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if cached, ok := r.accessors.Load(t); ok {
+		return cached.(*fieldAccessor), nil
+	}
+	ret, err := newFieldAccessor(t, r.path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field reference %v on %v: %w", r.literal, t.String(), err)
+	}
+	r.accessors.Store(t, ret)
+	return ret, nil
+}
+
+// newFieldRef parses and, for owner relative reference, precompiles other field reference
+func newFieldRef(owner reflect.Type, check *Check, literal string) (*fieldRef, error) {
+	literal = strings.TrimSpace(literal)
+	ret := &fieldRef{literal: literal}
+	path := literal
+	switch {
+	case strings.HasPrefix(path, rootAnchor+"."):
+		ret.anchor = rootRef
+		path = path[len(rootAnchor)+1:]
+	case strings.HasPrefix(path, parentAnchor+"."):
+		ret.anchor = parentRef
+		path = path[len(parentAnchor)+1:]
+	}
+	ret.path = strings.Split(path, ".")
+	for _, name := range ret.path {
+		if name == "" {
+			return nil, NewInvalidParameterError(check, literal, "expected field reference", nil)
+		}
+	}
+	if ret.anchor == ownerRef && owner != nil {
+		if _, err := ret.accessor(owner); err != nil {
+			return nil, NewInvalidParameterError(check, literal, "unknown field", err)
+		}
+	}
+	return ret, nil
+}
+
+// newFieldRefs creates field references for all check parameters
+func newFieldRefs(owner reflect.Type, check *Check, literals []string) ([]*fieldRef, error) {
+	var ret = make([]*fieldRef, 0, len(literals))
+	for _, literal := range literals {
+		ref, err := newFieldRef(owner, check, literal)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, ref)
+	}
+	return ret, nil
+}
+
+// newFieldAccessor compiles dotted field path, promoted fields of embedded structs are supported
+func newFieldAccessor(t reflect.Type, path []string) (*fieldAccessor, error) {
+	ret := &fieldAccessor{}
+	for _, name := range path {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%v is not a struct", t.String())
+		}
+		structField, ok := t.FieldByName(name)
+		if !ok {
+			return nil, fmt.Errorf("field %q was not found on %v", name, t.String())
+		}
+		if structField.PkgPath != "" {
+			return nil, fmt.Errorf("field %q is not accessible on %v", name, t.String())
+		}
+		for _, index := range structField.Index {
+			if t.Kind() == reflect.Ptr {
+				t = t.Elem()
+			}
+			field := t.Field(index)
+			ret.fields = append(ret.fields, xunsafe.NewField(field))
+			t = field.Type
+		}
+	}
+	return ret, nil
+}
+
+func (a *fieldAccessor) value(holder interface{}) interface{} {
+	ptr := xunsafe.AsPointer(holder)
+	last := len(a.fields) - 1
+	for i, field := range a.fields {
+		if ptr == nil {
+			return nil
+		}
+		if i == last {
+			break
+		}
+		if field.Kind() == reflect.Ptr {
+			ptr = field.ValuePointer(ptr)
+		} else {
+			ptr = field.Pointer(ptr)
+		}
+	}
+	return a.fields[last].Value(ptr)
+}
@@ -0,0 +1,174 @@
+package govalidator
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testLimits struct {
+		Max int
+	}
+
+	testAddress struct {
+		Country string
+	}
+
+	testAudit struct {
+		Owner string
+	}
+
+	testItem struct {
+		Quantity int    `validate:"ltfield($root.Limits.Max),lefield($parent.Budget)"`
+		Country  string `validate:"eqfield($root.Address.Country)"`
+	}
+
+	testOrder struct {
+		Limits  *testLimits
+		Address testAddress
+		Budget  int
+		Items   []*testItem
+	}
+)
+
+func TestService_Validate_FieldReferences(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "gefield",
+			input: struct {
+				Min int
+				Max int `validate:"gefield(Min)"`
+			}{Min: 3, Max: 3},
+		},
+		{
+			description: "ltfield fails",
+			input: struct {
+				Start string `validate:"ltfield(End)"`
+				End   string
+			}{Start: "b", End: "a"},
+			expectPaths: []string{"Start"},
+		},
+		{
+			description: "lefield",
+			input: struct {
+				Used  float64 `validate:"lefield(Quota)"`
+				Quota int
+			}{Used: 2.5, Quota: 3},
+		},
+		{
+			description: "exact integer comparison above 2^53",
+			input: struct {
+				Min     int64
+				Max     int64  `validate:"gtfield(Min)"`
+				Limit   uint64 `validate:"gtfield(Used)"`
+				Used    uint64
+				Same    int64 `validate:"eqfield(Min)"`
+				Float   float64
+				Precise int64 `validate:"gtfield(Float)"`
+			}{Min: 9007199254740992, Max: 9007199254740993, Limit: 18446744073709551615, Used: 18446744073709551614, Same: 9007199254740993, Float: 9007199254740992, Precise: 9007199254740993},
+			expectPaths: []string{"Same"},
+		},
+		{
+			description: "NaN is not comparable",
+			input: struct {
+				Min float64
+				Max float64 `validate:"gefield(Min)"`
+			}{Min: math.NaN(), Max: 1},
+			expectPaths: []string{"Max"},
+		},
+		{
+			description: "dotted reference",
+			input: struct {
+				Address  *testAddress
+				Shipping string `validate:"eqfield(Address.Country)"`
+			}{Address: &testAddress{Country: "US"}, Shipping: "CA"},
+			expectPaths: []string{"Shipping"},
+		},
+		{
+			description: "dotted reference with nil intermediate pointer",
+			input: struct {
+				Address *testAddress
+				Phone   string `validate:"required_with(Address.Country)"`
+			}{},
+		},
+		{
+			description: "compare with nil intermediate pointer",
+			input: struct {
+				Limits   *testLimits
+				Quantity int `validate:"ltfield(Limits.Max)"`
+			}{Quantity: 1},
+			expectPaths: []string{"Quantity"},
+		},
+		{
+			description: "root reference with nil intermediate pointer",
+			input:       &testOrder{Budget: 5, Items: []*testItem{{Quantity: 1}}},
+			expectPaths: []string{"Items[0].Quantity"},
+		},
+		{
+			description: "promoted field reference",
+			input: struct {
+				testAudit
+				Approver string `validate:"nefield(Owner)"`
+			}{testAudit: testAudit{Owner: "bob"}, Approver: "bob"},
+			expectPaths: []string{"Approver"},
+		},
+		{
+			description: "root and parent references",
+			input: &testOrder{
+				Limits:  &testLimits{Max: 10},
+				Address: testAddress{Country: "US"},
+				Budget:  5,
+				Items: []*testItem{
+					{Quantity: 4, Country: "US"},
+					{Quantity: 7, Country: "CA"},
+					{Quantity: 12, Country: "US"},
+				},
+			},
+			expectPaths: []string{"Items[1].Quantity", "Items[1].Country", "Items[2].Quantity"},
+		},
+		{
+			description: "unknown field",
+			input: struct {
+				Address  testAddress
+				Shipping string `validate:"eqfield(Address.Region)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func TestNewChecks_FieldReferenceError(t *testing.T) {
+	_, err := NewChecks(reflect.TypeOf(struct {
+		Max int `validate:"gefield(Min)"`
+	}{}))
+	var paramErr *InvalidParameterError
+	if assert.ErrorAs(t, err, &paramErr) {
+		assert.EqualValues(t, "gefield", paramErr.Check)
+		assert.EqualValues(t, "Min", paramErr.Parameter)
+	}
+}
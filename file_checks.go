@@ -0,0 +1,160 @@
+package govalidator
+
+import (
+	"context"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// fileSizeUnits maps size unit suffix to number of bytes, longer suffixes have to be matched first
+var fileSizeUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"KIB", 1 << 10}, {"MIB", 1 << 20}, {"GIB", 1 << 30}, {"TIB", 1 << 40},
+	{"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9}, {"TB", 1e12},
+	{"B", 1},
+}
+
+// NewFile creates regular file existence check
+func NewFile() func(field *Field, check *Check) (IsValid, error) {
+	return newFileInfoCheck(func(info fs.FileInfo) bool {
+		return info.Mode().IsRegular()
+	})
+}
+
+// NewDir creates directory existence check
+func NewDir() func(field *Field, check *Check) (IsValid, error) {
+	return newFileInfoCheck(func(info fs.FileInfo) bool {
+		return info.IsDir()
+	})
+}
+
+// NewMaxFileSize creates regular file size check, size takes B, KB, MB, GB, TB (decimal) or KiB, MiB, GiB, TiB (binary) unit, i.e. maxfilesize(10MB)
+func NewMaxFileSize() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		limit, err := parseFileSize(check.Parameters[0])
+		if err != nil {
+			return nil, NewInvalidParameterError(check, check.Parameters[0], "expected file size, i.e. 10MB", err)
+		}
+		return newFileInfoCheck(func(info fs.FileInfo) bool {
+			return info.Mode().IsRegular() && info.Size() <= limit
+		})(field, check)
+	}
+}
+
+// NewReadable creates check that file or directory can be opened for reading
+func NewReadable() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		return newStringValueCheck(field, check, func(ctx context.Context, actual string) (bool, error) {
+			fsys, name, ok := resolveFSPath(ctx, actual)
+			if !ok {
+				return false, nil
+			}
+			file, err := fsys.Open(name)
+			if err != nil {
+				return false, nil
+			}
+			return true, file.Close()
+		})
+	}
+}
+
+// NewFileExt creates file extension check, extensions are matched case insensitively, i.e. fileext(.json,.yaml)
+func NewFileExt() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
+		}
+		extensions := map[string]bool{}
+		for _, param := range check.Parameters {
+			ext := strings.ToLower(strings.TrimSpace(param))
+			if ext == "" || ext == "." {
+				return nil, NewInvalidParameterError(check, param, "expected file extension", nil)
+			}
+			if !strings.HasPrefix(ext, ".") {
+				ext = "." + ext
+			}
+			extensions[ext] = true
+		}
+		return newFormatCheck("fileext", func(actual string) bool {
+			return extensions[strings.ToLower(path.Ext(filepath.ToSlash(actual)))]
+		})(field, check)
+	}
+}
+
+// WithFS creates with file system option used by file checks, paths are resolved relative to file system root
+func WithFS(fsys fs.FS) Option {
+	return func(c *Options) {
+		c.FS = fsys
+	}
+}
+
+func newFileInfoCheck(predicate func(info fs.FileInfo) bool) func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		return newStringValueCheck(field, check, func(ctx context.Context, actual string) (bool, error) {
+			fsys, name, ok := resolveFSPath(ctx, actual)
+			if !ok {
+				return false, nil
+			}
+			info, err := fs.Stat(fsys, name)
+			if err != nil {
+				return false, nil
+			}
+			return predicate(info), nil
+		})
+	}
+}
+
+// resolveFSPath returns session file system with path name, without WithFS option paths are resolved with os.DirFS against absolute path
+func resolveFSPath(ctx context.Context, location string) (fs.FS, string, bool) {
+	if location == "" {
+		return nil, "", false
+	}
+	if session, ok := ctx.Value(SessionKey).(*Session); ok && session != nil && session.fs != nil {
+		name := strings.TrimPrefix(path.Clean(filepath.ToSlash(location)), "/")
+		if name == "" {
+			name = "."
+		}
+		return session.fs, name, fs.ValidPath(name)
+	}
+	absolute, err := filepath.Abs(location)
+	if err != nil {
+		return nil, "", false
+	}
+	volume := filepath.VolumeName(absolute)
+	name := strings.TrimPrefix(filepath.ToSlash(absolute[len(volume):]), "/")
+	if name == "" {
+		name = "."
+	}
+	return os.DirFS(volume + string(filepath.Separator)), name, fs.ValidPath(name)
+}
+
+func parseFileSize(literal string) (int64, error) {
+	literal = strings.ToUpper(strings.TrimSpace(literal))
+	multiplier := int64(1)
+	for _, unit := range fileSizeUnits {
+		if strings.HasSuffix(literal, unit.suffix) {
+			multiplier = unit.bytes
+			literal = strings.TrimSpace(strings.TrimSuffix(literal, unit.suffix))
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(literal, 64)
+	if err != nil {
+		return 0, err
+	}
+	size := value * float64(multiplier)
+	if math.IsNaN(size) || size < 0 || size >= math.MaxInt64 { //NaN, infinity and values out of int64 range
+		return 0, strconv.ErrRange
+	}
+	return int64(size), nil
+}
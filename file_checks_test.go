@@ -0,0 +1,125 @@
+package govalidator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_FileChecks(t *testing.T) {
+	fsys := fstest.MapFS{
+		"etc/app/config.json": {Data: []byte(`{}`)},
+		"etc/app/rules.YAML":  {Data: []byte(`rules: []`)},
+		"var/data/large.bin":  {Data: []byte(strings.Repeat("x", 2048))},
+		"var/data/cache":      {Mode: os.ModeDir},
+	}
+	var testCases = []struct {
+		description string
+		input       interface{}
+		options     []Option
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "file and dir",
+			input: struct {
+				Config  string   `validate:"file"`
+				Cache   *string  `validate:"dir"`
+				Inputs  []string `validate:"file"`
+				Missing string   `validate:"dir"`
+			}{Config: "/etc/app/config.json", Cache: stringPtr("var/data/cache"), Inputs: []string{"etc/app/rules.YAML", "var/data", "etc/../etc/app/config.json"}, Missing: "tmp"},
+			options:     []Option{WithFS(fsys)},
+			expectPaths: []string{"Missing", "Inputs[1]"},
+		},
+		{
+			description: "fileext",
+			input: struct {
+				Configs []string `validate:"fileext(.json,yaml)"`
+			}{Configs: []string{"config.json", "rules.YAML", "notes.txt", "json"}},
+			expectPaths: []string{"Configs[2]", "Configs[3]"},
+		},
+		{
+			description: "readable and maxfilesize",
+			input: struct {
+				Config  string `validate:"readable,maxfilesize(1KiB)"`
+				Large   string `validate:"maxfilesize(1KiB)"`
+				Allowed string `validate:"maxfilesize(2.048KB)"`
+				Missing string `validate:"readable"`
+				Outside string `validate:"readable"`
+			}{Config: "etc/app/config.json", Large: "var/data/large.bin", Allowed: "var/data/large.bin", Missing: "etc/app/missing.json", Outside: "../etc/app/config.json"},
+			options:     []Option{WithFS(fsys)},
+			expectPaths: []string{"Large", "Missing", "Outside"},
+		},
+		{
+			description: "invalid file size",
+			input: struct {
+				Path string `validate:"maxfilesize(10XB)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "infinite file size",
+			input: struct {
+				Path string `validate:"maxfilesize(Inf)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "NaN file size",
+			input: struct {
+				Path string `validate:"maxfilesize(NaN)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "file size out of range",
+			input: struct {
+				Path string `validate:"maxfilesize(1e30GB)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input, testCase.options...)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func TestService_Validate_FileChecksDefaultFS(t *testing.T) {
+	dir := t.TempDir()
+	location := filepath.Join(dir, "config.json")
+	if !assert.Nil(t, os.WriteFile(location, []byte(`{}`), 0644)) {
+		return
+	}
+	input := struct {
+		Dir     string `validate:"dir"`
+		Config  string `validate:"file,readable,maxfilesize(1KB)"`
+		Missing string `validate:"file"`
+	}{Dir: dir, Config: location, Missing: filepath.Join(dir, "missing.json")}
+	validation, err := New().Validate(context.Background(), input)
+	if !assert.Nil(t, err) {
+		return
+	}
+	var paths []string
+	for _, violation := range validation.Violations {
+		paths = append(paths, violation.Location)
+	}
+	assert.EqualValues(t, []string{"Missing"}, paths)
+}
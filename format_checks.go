@@ -3,13 +3,15 @@ package govalidator
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net"
 	"net/url"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata"
 )
 
 var (
@@ -124,7 +126,7 @@ func NewPort() func(field *Field, check *Check) (IsValid, error) {
 				return numeric >= 1 && numeric <= 65535 && numeric == float64(int64(numeric)), nil
 			}, nil
 		}
-		return nil, fmt.Errorf("unsupported port type: %s", field.Type.String())
+		return nil, NewUnsupportedTypeError(field, check)
 	}
 }
 
@@ -148,62 +150,120 @@ func NewSemver() func(field *Field, check *Check) (IsValid, error) {
 
 func NewJSON() func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		kind, elemKind := typeKinds(field)
-		switch kind {
-		case reflect.String:
-			return func(ctx context.Context, value interface{}) (bool, error) {
-				actual, ok := asStringValue(value)
-				if !ok {
-					return false, nil
-				}
-				return json.Valid([]byte(actual)), nil
-			}, nil
-		case reflect.Slice:
-			if elemKind == reflect.String {
-				return func(ctx context.Context, value interface{}) (bool, error) {
-					actual, ok := asStringValue(value)
-					if !ok {
-						return false, nil
-					}
-					return json.Valid([]byte(actual)), nil
-				}, nil
-			}
-			if elemKind == reflect.Uint8 {
-				return func(ctx context.Context, value interface{}) (bool, error) {
-					bytes, ok := value.([]byte)
-					if !ok {
-						return false, nil
-					}
-					return json.Valid(bytes), nil
-				}, nil
-			}
+		return newBytesValueCheck(field, check, func(ctx context.Context, actual []byte) (bool, error) {
+			return json.Valid(actual), nil
+		})
+	}
+}
+
+// NewTimezone creates IANA time zone name check, i.e. Europe/Warsaw
+func NewTimezone() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("timezone", isTimezone)
+}
+
+// NewCron creates cron expression check, 5 or 6 (with seconds) fields or descriptor, i.e. */5 * * * * or @daily
+func NewCron() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("cron", isCron)
+}
+
+// NewDurationFormat creates Go duration string check, i.e. 1h30m
+func NewDurationFormat() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("duration", func(actual string) bool {
+		_, err := time.ParseDuration(actual)
+		return err == nil
+	})
+}
+
+// NewDatetime creates Go time layout check, layout defaults to RFC 3339, i.e. datetime(2006-01-02) or datetime(02 Jan 2006 15:04 MST)
+func NewDatetime() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if len(check.Parameters) > 1 {
+			return nil, NewInvalidParameterError(check, check.Parameters[1], "expected single layout, quote layout with commas, i.e. datetime('Mon, 02 Jan 2006')", nil)
+		}
+		layout := time.RFC3339
+		if len(check.Parameters) == 1 {
+			layout = check.Parameters[0]
 		}
-		return nil, fmt.Errorf("unsupported json type: %s", field.Type.String())
+		return newFormatCheck("datetime", func(actual string) bool {
+			_, err := time.Parse(layout, actual)
+			return err == nil
+		})(field, check)
 	}
 }
 
 func newFormatCheck(name string, predicate func(actual string) bool) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		kind, elemKind := typeKinds(field)
-		switch kind {
-		case reflect.String:
-		case reflect.Slice:
-			if elemKind != reflect.String {
-				return nil, fmt.Errorf("unsupported %s type: %s", name, field.Type.String())
-			}
-		default:
-			return nil, fmt.Errorf("unsupported %s type: %s", name, field.Type.String())
-		}
-		return func(ctx context.Context, value interface{}) (bool, error) {
-			actual, ok := asStringValue(value)
-			if !ok {
-				return false, nil
-			}
+		return newStringValueCheck(field, check, func(ctx context.Context, actual string) (bool, error) {
 			return predicate(actual), nil
-		}, nil
+		})
 	}
 }
 
+// newStringValueCheck creates check for string, *string and []string elements, nil values fail
+func newStringValueCheck(field *Field, check *Check, isValid func(ctx context.Context, actual string) (bool, error)) (IsValid, error) {
+	kind, elemKind := typeKinds(field)
+	switch kind {
+	case reflect.String:
+	case reflect.Slice:
+		if elemKind != reflect.String {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+	default:
+		return nil, NewUnsupportedTypeError(field, check)
+	}
+	return func(ctx context.Context, value interface{}) (bool, error) {
+		actual, ok := asStringValue(value)
+		if !ok {
+			return false, nil
+		}
+		return isValid(ctx, actual)
+	}, nil
+}
+
+// newBytesValueCheck creates check for []byte (including named types, i.e. json.RawMessage), *[]byte, string, *string and []string elements, nil values fail
+func newBytesValueCheck(field *Field, check *Check, isValid func(ctx context.Context, actual []byte) (bool, error)) (IsValid, error) {
+	kind, elemKind := typeKinds(field)
+	switch kind {
+	case reflect.String:
+	case reflect.Slice:
+		if elemKind != reflect.String && elemKind != reflect.Uint8 {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+	default:
+		return nil, NewUnsupportedTypeError(field, check)
+	}
+	return func(ctx context.Context, value interface{}) (bool, error) {
+		actual, ok := asBytesValue(value)
+		if !ok {
+			return false, nil
+		}
+		return isValid(ctx, actual)
+	}, nil
+}
+
+// asBytesValue returns bytes of string or byte slice value
+func asBytesValue(value interface{}) ([]byte, bool) {
+	switch actual := value.(type) {
+	case []byte:
+		return actual, actual != nil
+	case string:
+		return []byte(actual), true
+	}
+	rv, isNil := derefReflectValue(value)
+	if isNil {
+		return nil, false
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), true
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 && !rv.IsNil() {
+			return rv.Bytes(), true
+		}
+	}
+	return nil, false
+}
+
 func isValidPort(actual string) bool {
 	numeric, err := strconv.Atoi(actual)
 	if err != nil {
@@ -211,3 +271,109 @@ func isValidPort(actual string) bool {
 	}
 	return numeric >= 1 && numeric <= 65535
 }
+
+// timezones caches successfully loaded IANA location names, invalid names are not cached so arbitrary input cannot grow it
+var timezones sync.Map
+
+// isTimezone returns true for IANA time zone name, i.e. Europe/Warsaw, machine dependent Local and empty name are rejected
+func isTimezone(actual string) bool {
+	if actual == "" || actual == "Local" {
+		return false
+	}
+	if _, ok := timezones.Load(actual); ok {
+		return true
+	}
+	if _, err := time.LoadLocation(actual); err != nil {
+		return false
+	}
+	timezones.Store(actual, true)
+	return true
+}
+
+type cronField struct {
+	min, max int
+	names    []string
+	anyMark  bool
+}
+
+var (
+	cronSeconds = &cronField{max: 59}
+	cronFields  = []*cronField{
+		{max: 59},
+		{max: 23},
+		{min: 1, max: 31, anyMark: true},
+		{min: 1, max: 12, names: []string{"", "jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}},
+		{max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}, anyMark: true},
+	}
+	cronDescriptors = map[string]bool{"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true, "@daily": true, "@midnight": true, "@hourly": true}
+)
+
+// isCron returns true for 5 field (minute hour day month weekday) or 6 field (with leading seconds) cron expression or predefined descriptor
+func isCron(actual string) bool {
+	expr := strings.TrimSpace(actual)
+	if strings.HasPrefix(expr, "@") {
+		return cronDescriptors[strings.ToLower(expr)]
+	}
+	parts := strings.Fields(expr)
+	fields := cronFields
+	switch len(parts) {
+	case 5:
+	case 6:
+		fields = append([]*cronField{cronSeconds}, cronFields...)
+	default:
+		return false
+	}
+	for i, part := range parts {
+		if !fields[i].isValid(part) {
+			return false
+		}
+	}
+	return true
+}
+
+// isValid returns true for comma separated list of *, ?, values, ranges and their /steps
+func (f *cronField) isValid(expr string) bool {
+	for _, item := range strings.Split(expr, ",") {
+		base, step := item, ""
+		if index := strings.Index(item, "/"); index != -1 {
+			base, step = item[:index], item[index+1:]
+			if value, err := strconv.Atoi(step); err != nil || value < 1 || value > f.max {
+				return false
+			}
+		}
+		switch base {
+		case "*":
+			continue
+		case "?":
+			if !f.anyMark || step != "" {
+				return false
+			}
+			continue
+		}
+		from, to := base, base
+		if index := strings.Index(base, "-"); index != -1 {
+			from, to = base[:index], base[index+1:]
+		}
+		low, ok := f.value(from)
+		if !ok {
+			return false
+		}
+		high, ok := f.value(to)
+		if !ok || high < low {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *cronField) value(literal string) (int, bool) {
+	if value, err := strconv.Atoi(literal); err == nil {
+		return value, value >= f.min && value <= f.max
+	}
+	for i, name := range f.names {
+		if name != "" && strings.EqualFold(name, literal) {
+			return i, true
+		}
+	}
+	return 0, false
+}
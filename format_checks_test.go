@@ -0,0 +1,86 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_SchedulingFormats(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "timezone",
+			input: struct {
+				Zones []string `validate:"timezone"`
+				Zone  *string  `validate:"timezone"`
+			}{Zones: []string{"Europe/Warsaw", "UTC", "America/Argentina/Buenos_Aires", "Mars/Olympus", "Local", "../etc/passwd"}, Zone: stringPtr("Asia/Tokyo")},
+			expectPaths: []string{"Zones[3]", "Zones[4]", "Zones[5]"},
+		},
+		{
+			description: "cron",
+			input: struct {
+				Schedules []string `validate:"cron"`
+			}{Schedules: []string{
+				"*/5 * * * *", "0 9-17 * * MON-FRI", "30 0 1,15 * ?", "0 0 0 1 JAN *", "15 10 * * 7", "@daily", "0 0/10 * * * *",
+				"* * * *", "60 * * * *", "0 24 * * *", "0 0 ? * ?/2", "5-1 * * * *", "*/0 * * * *", "0 0 * FOO *", "@often",
+			}},
+			expectPaths: []string{"Schedules[7]", "Schedules[8]", "Schedules[9]", "Schedules[10]", "Schedules[11]", "Schedules[12]", "Schedules[13]", "Schedules[14]"},
+		},
+		{
+			description: "duration",
+			input: struct {
+				Timeout  string `validate:"duration"`
+				Interval string `validate:"duration"`
+				Invalid  string `validate:"duration"`
+			}{Timeout: "1h30m", Interval: "0", Invalid: "5 minutes"},
+			expectPaths: []string{"Invalid"},
+		},
+		{
+			description: "datetime",
+			input: struct {
+				Default string   `validate:"datetime"`
+				Date    string   `validate:"datetime(2006-01-02)"`
+				Header  string   `validate:"datetime(02 Jan 2006 15:04 MST)"`
+				Dates   []string `validate:"datetime(2006-01-02)"`
+				Quoted  string   `validate:"datetime('Mon, 02 Jan 2006')"`
+			}{Default: "2024-02-29T10:00:00Z", Date: "2024-02-29", Header: "29 Feb 2024 10:00 UTC", Dates: []string{"2023-02-29", "2023-12-01"}, Quoted: "Thu, 29 Feb 2024"},
+			expectPaths: []string{"Dates[0]"},
+		},
+		{
+			description: "unquoted datetime layout with comma",
+			input: struct {
+				Date string `validate:"datetime(Mon, 02 Jan 2006)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Zone int `validate:"timezone"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
@@ -1,11 +1,14 @@
 module github.com/viant/govalidator
 
-go 1.17
+go 1.18
 
 require (
+	github.com/rivo/uniseg v0.4.7
 	github.com/stretchr/testify v1.8.4
 	github.com/viant/structology v0.2.0
 	github.com/viant/xunsafe v0.8.4
+	golang.org/x/net v0.17.0
+	golang.org/x/text v0.13.0
 )
 
 require (
@@ -0,0 +1,285 @@
+package govalidator
+
+import (
+	"strings"
+)
+
+type (
+	//cardBrand represents payment card brand issuer prefixes and allowed number lengths
+	cardBrand struct {
+		name     string
+		prefixes []cardPrefix
+		lengths  []int
+	}
+
+	//cardPrefix represents inclusive issuer prefix range of the same digit count
+	cardPrefix struct {
+		from, to int
+	}
+)
+
+// cardBrands are matched in order, the first brand with matching prefix wins
+var cardBrands = []*cardBrand{
+	{name: "amex", prefixes: []cardPrefix{{34, 34}, {37, 37}}, lengths: []int{15}},
+	{name: "visa", prefixes: []cardPrefix{{4, 4}}, lengths: []int{13, 16, 19}},
+	{name: "mastercard", prefixes: []cardPrefix{{51, 55}, {2221, 2720}}, lengths: []int{16}},
+	{name: "discover", prefixes: []cardPrefix{{6011, 6011}, {644, 649}, {65, 65}, {622126, 622925}}, lengths: []int{16, 17, 18, 19}},
+	{name: "jcb", prefixes: []cardPrefix{{3528, 3589}}, lengths: []int{16, 17, 18, 19}},
+	{name: "dinersclub", prefixes: []cardPrefix{{300, 305}, {36, 36}, {38, 39}}, lengths: []int{14, 15, 16, 17, 18, 19}},
+	{name: "unionpay", prefixes: []cardPrefix{{62, 62}}, lengths: []int{16, 17, 18, 19}},
+	{name: "maestro", prefixes: []cardPrefix{{50, 50}, {56, 69}}, lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// swiftKosovoCode is user assigned country code used by SWIFT for Kosovo
+const swiftKosovoCode = "XK"
+
+// ibanLengths maps IBAN country code to its total length, as published in the SWIFT IBAN registry
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AX": 18, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22,
+	"BI": 27, "BL": 27, "BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27,
+	"DK": 18, "DO": 28, "EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22,
+	"GE": 22, "GF": 27, "GG": 22, "GI": 23, "GL": 18, "GP": 27, "GR": 27, "GT": 28, "HR": 21, "HU": 28,
+	"IE": 22, "IL": 23, "IM": 22, "IQ": 23, "IS": 26, "IT": 27, "JE": 22, "JO": 30, "KW": 30, "KZ": 20,
+	"LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22,
+	"MF": 27, "MK": 19, "MN": 20, "MQ": 27, "MR": 27, "MT": 31, "MU": 30, "NC": 27, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PF": 27, "PK": 24, "PL": 28, "PM": 27, "PS": 29, "PT": 25, "QA": 29, "RE": 27,
+	"RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27,
+	"SO": 23, "ST": 25, "SV": 28, "TF": 27, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24,
+	"WF": 27, "XK": 20, "YE": 30, "YT": 27,
+}
+
+// NewISBN10 creates ISBN-10 check with mod 11 check digit verification, hyphens and spaces are ignored
+func NewISBN10() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("isbn10", isISBN10)
+}
+
+// NewISBN13 creates ISBN-13 check with 978/979 prefix and check digit verification, hyphens and spaces are ignored
+func NewISBN13() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("isbn13", isISBN13)
+}
+
+// NewLuhn creates Luhn (mod 10) check digit check for digit strings
+func NewLuhn() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("luhn", func(actual string) bool {
+		return len(actual) > 1 && isDigits(actual) && isLuhn(actual)
+	})
+}
+
+// NewCreditCard creates payment card number check, optional parameters restrict detected brand, i.e. creditcard(visa,mastercard)
+func NewCreditCard() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		allowed := map[string]bool{}
+		for _, param := range check.Parameters {
+			name := strings.ToLower(strings.TrimSpace(param))
+			if lookupCardBrand(name) == nil {
+				return nil, NewInvalidParameterError(check, param, "unknown card brand", nil)
+			}
+			allowed[name] = true
+		}
+		return newFormatCheck("creditcard", func(actual string) bool {
+			number := stripSeparators(actual)
+			if len(number) < 12 || len(number) > 19 || !isDigits(number) || !isLuhn(number) {
+				return false
+			}
+			if len(allowed) == 0 {
+				return true
+			}
+			brand := detectCardBrand(number)
+			return brand != nil && allowed[brand.name]
+		})(field, check)
+	}
+}
+
+// NewIBAN creates IBAN check with country length and mod 97 check digits verification, spaces are ignored
+func NewIBAN() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("iban", isIBAN)
+}
+
+// NewEAN8 creates EAN-8 check digit check
+func NewEAN8() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("ean8", func(actual string) bool {
+		return isGTIN(actual, 8)
+	})
+}
+
+// NewEAN13 creates EAN-13 check digit check
+func NewEAN13() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("ean13", func(actual string) bool {
+		return isGTIN(actual, 13)
+	})
+}
+
+// NewUPC creates UPC-A check digit check
+func NewUPC() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("upc", func(actual string) bool {
+		return isGTIN(actual, 12)
+	})
+}
+
+// NewBIC creates BIC (SWIFT code) check, country code has to be a valid ISO 3166 alpha-2 code
+func NewBIC() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("bic", isBIC)
+}
+
+func isISBN10(actual string) bool {
+	isbn := stripSeparators(actual)
+	if len(isbn) != 10 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		digit := int(isbn[i] - '0')
+		if i == 9 && (isbn[i] == 'X' || isbn[i] == 'x') {
+			digit = 10
+		} else if digit < 0 || digit > 9 {
+			return false
+		}
+		sum += (10 - i) * digit
+	}
+	return sum%11 == 0
+}
+
+func isISBN13(actual string) bool {
+	isbn := stripSeparators(actual)
+	if !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+		return false
+	}
+	return isGTIN(isbn, 13)
+}
+
+// isGTIN returns true if actual is GS1 (EAN/UPC) number of expected length with valid check digit
+func isGTIN(actual string, length int) bool {
+	if len(actual) != length || !isDigits(actual) {
+		return false
+	}
+	sum := 0
+	for i := length - 2; i >= 0; i-- {
+		digit := int(actual[i] - '0')
+		if (length-2-i)%2 == 0 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return (10-sum%10)%10 == int(actual[length-1]-'0')
+}
+
+func isLuhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if double {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func isIBAN(actual string) bool {
+	iban := strings.ToUpper(strings.Replace(actual, " ", "", -1))
+	if len(iban) < 5 {
+		return false
+	}
+	length, ok := ibanLengths[iban[:2]]
+	if !ok || len(iban) != length || !isDigits(iban[2:4]) {
+		return false
+	}
+	remainder := 0
+	rearranged := iban[4:] + iban[:4]
+	for i := 0; i < len(rearranged); i++ {
+		c := rearranged[i]
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+func isBIC(actual string) bool {
+	if !bicRegex.MatchString(actual) {
+		return false
+	}
+	country := strings.ToUpper(actual[4:6])
+	return iso3166Alpha2[country] || country == swiftKosovoCode
+}
+
+func detectCardBrand(number string) *cardBrand {
+	for _, brand := range cardBrands {
+		if !containsInt(brand.lengths, len(number)) {
+			continue
+		}
+		for _, prefix := range brand.prefixes {
+			if prefix.matches(number) {
+				return brand
+			}
+		}
+	}
+	return nil
+}
+
+func lookupCardBrand(name string) *cardBrand {
+	for _, brand := range cardBrands {
+		if brand.name == name {
+			return brand
+		}
+	}
+	return nil
+}
+
+func (p cardPrefix) matches(number string) bool {
+	digits := 0
+	for value := p.from; value > 0; value /= 10 {
+		digits++
+	}
+	if len(number) < digits {
+		return false
+	}
+	value := 0
+	for i := 0; i < digits; i++ {
+		value = value*10 + int(number[i]-'0')
+	}
+	return value >= p.from && value <= p.to
+}
+
+// stripSeparators removes hyphens and spaces used for grouping digits
+func stripSeparators(actual string) string {
+	if !strings.ContainsAny(actual, "- ") {
+		return actual
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, actual)
+}
+
+func isDigits(actual string) bool {
+	if actual == "" {
+		return false
+	}
+	for i := 0; i < len(actual); i++ {
+		if actual[i] < '0' || actual[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func containsInt(values []int, candidate int) bool {
+	for _, value := range values {
+		if value == candidate {
+			return true
+		}
+	}
+	return false
+}
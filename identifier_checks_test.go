@@ -0,0 +1,118 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_IdentifierChecks(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "isbn",
+			input: struct {
+				ISBN10  string  `validate:"isbn10"`
+				ISBN10X string  `validate:"isbn10"`
+				ISBN13  *string `validate:"isbn13"`
+			}{ISBN10: "0-306-40615-2", ISBN10X: "080442957X", ISBN13: stringPtr("978-0-306-40615-7")},
+		},
+		{
+			description: "isbn checksum fails",
+			input: struct {
+				ISBN10 string   `validate:"isbn10"`
+				ISBN13 []string `validate:"isbn13"`
+			}{ISBN10: "0306406153", ISBN13: []string{"9780306406157", "9780306406158", "4006381333931"}},
+			expectPaths: []string{"ISBN10", "ISBN13[1]", "ISBN13[2]"},
+		},
+		{
+			description: "luhn",
+			input: struct {
+				Valid   string `validate:"luhn"`
+				Invalid string `validate:"luhn"`
+			}{Valid: "79927398713", Invalid: "79927398710"},
+			expectPaths: []string{"Invalid"},
+		},
+		{
+			description: "creditcard",
+			input: struct {
+				Visa       string `validate:"creditcard"`
+				Mastercard string `validate:"creditcard(visa,mastercard)"`
+				Amex       string `validate:"creditcard(visa)"`
+				Checksum   string `validate:"creditcard"`
+			}{Visa: "4111 1111 1111 1111", Mastercard: "5555-5555-5555-4444", Amex: "378282246310005", Checksum: "4111111111111112"},
+			expectPaths: []string{"Amex", "Checksum"},
+		},
+		{
+			description: "unknown card brand",
+			input: struct {
+				Card string `validate:"creditcard(acme)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "iban",
+			input: struct {
+				Print      string `validate:"iban"`
+				Electronic string `validate:"iban"`
+				Checksum   string `validate:"iban"`
+				Length     string `validate:"iban"`
+				Country    string `validate:"iban"`
+			}{
+				Print:      "GB82 WEST 1234 5698 7654 32",
+				Electronic: "DE89370400440532013000",
+				Checksum:   "GB82WEST12345698765433",
+				Length:     "DE8937040044053201300",
+				Country:    "ZZ89370400440532013000",
+			},
+			expectPaths: []string{"Checksum", "Length", "Country"},
+		},
+		{
+			description: "ean and upc",
+			input: struct {
+				EAN8    string `validate:"ean8"`
+				EAN13   string `validate:"ean13"`
+				UPC     string `validate:"upc"`
+				Invalid string `validate:"ean8"`
+			}{EAN8: "96385074", EAN13: "4006381333931", UPC: "036000291452", Invalid: "96385075"},
+			expectPaths: []string{"Invalid"},
+		},
+		{
+			description: "bic",
+			input: struct {
+				Bank    string `validate:"bic"`
+				Branch  string `validate:"bic"`
+				Country string `validate:"bic"`
+			}{Bank: "DEUTDEFF", Branch: "DEUTDEFF500", Country: "DEUTZZFF"},
+			expectPaths: []string{"Country"},
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Card int `validate:"luhn"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
@@ -17,11 +17,20 @@ func init() {
 	Register("hsla", NewRegExprCheck(hslaRegex))
 	Register("e164", NewRegExprCheck(e164Regex))
 	Register("localPhone", NewRegExprCheck(localPhoneRegex))
-	Register("email", NewRegExprCheck(emailRegex))
-	Register("base64", NewRegExprCheck(base64Regex))
+	Register("email", NewEmail())
+	Register("base64", NewBase64())
 	Register("base64URL", NewRegExprCheck(base64URLRegex))
-	Register("iSBN10", NewRegExprCheck(iSBN10Regex))
-	Register("iSBN13", NewRegExprCheck(iSBN13Regex))
+	Register("base64len", NewBase64Len())
+	Register("hexlen", NewHexLen())
+	Register("utf8", NewUTF8())
+	Register("isbn10", NewISBN10())
+	Register("isbn13", NewISBN13())
+	Register("luhn", NewLuhn())
+	Register("creditcard", NewCreditCard())
+	Register("iban", NewIBAN())
+	Register("ean8", NewEAN8())
+	Register("ean13", NewEAN13())
+	Register("upc", NewUPC())
 	Register("uUID3", NewRegExprCheck(uUID3Regex))
 	Register("uUID4", NewRegExprCheck(uUID4Regex))
 	Register("uUID5", NewRegExprCheck(uUID5Regex))
@@ -48,6 +57,13 @@ func init() {
 	Register("aSCII", NewRegExprCheck(aSCIIRegex))
 	Register("printableASCII", NewRegExprCheck(printableASCIIRegex))
 	Register("multibyte", NewRegExprCheck(multibyteRegex))
+	Register("printable", NewPrintable())
+	Register("nocontrol", NewNoControl())
+	Register("nfc", NewNFC())
+	Register("nfkc", NewNFKC())
+	Register("maxbytes", NewMaxBytes())
+	Register("graphemes", NewGraphemes())
+	Register("script", NewScript())
 	Register("dataURI", NewRegExprCheck(dataURIRegex))
 
 	Register("latitude", NewRegExprCheck(latitudeRegex))
@@ -62,8 +78,14 @@ func init() {
 
 	Register("hTMLEncoded", NewRegExprCheck(hTMLEncodedRegex))
 	Register("hTML", NewRegExprCheck(hTMLRegex))
-	Register("jWT", NewRegExprCheck(jWTRegex))
-	Register("bic", NewRegExprCheck(bicRegex))
+	Register("jwt", NewJWT())
+	Register("bic", NewBIC())
+	Register("iso3166_alpha2", NewISO3166Alpha2())
+	Register("iso3166_alpha3", NewISO3166Alpha3())
+	Register("iso3166_numeric", NewISO3166Numeric())
+	Register("iso4217", NewISO4217())
+	Register("iso639_1", NewISO639Alpha2())
+	Register("bcp47", NewBCP47())
 	Register("dnsRegexRFC1035Label", NewRegExprCheck(dnsRegexRFC1035Label))
 	Register("iabcategory", NewRegExprCheck(iabCategory))
 	Register("iabcategories", NewRepeatedRegExprCheck(iabCategory, ","))
@@ -78,19 +100,45 @@ func init() {
 	Register("min", NewMin())
 	Register("max", NewMax())
 	Register("between", NewBetween())
+	Register("multipleof", NewMultipleOf())
+	Register("step", NewStep())
+	Register("decimal", NewDecimal())
 	Register("contains", NewContains())
+	RegisterCollection("unique", NewUnique())
+	RegisterCollection("sorted", NewSorted())
+	RegisterCollection("containsall", NewContainsAll())
+	RegisterCollection("containsany", NewContainsAny())
+	RegisterCollection("excludesall", NewExcludesAll())
+	Register("minkeys", NewMinKeys())
+	Register("maxkeys", NewMaxKeys())
+	Register("haskeys", NewHasKeys())
+	Register("keyspattern", NewKeysPattern())
+	Register("allowkeys", NewAllowKeys())
 	Register("notcontains", NewNotContains())
 	Register("startswith", NewStartsWith())
 	Register("endswith", NewEndsWith())
 	Register("eqfield", NewEqField())
 	Register("nefield", NewNeField())
 	Register("gtfield", NewGtField())
+	Register("gefield", NewGeField())
+	Register("ltfield", NewLtField())
+	Register("lefield", NewLeField())
 	Register("required_if", NewRequiredIf())
 	Register("required_unless", NewRequiredUnless())
 	Register("required_with", NewRequiredWith())
 	Register("required_without", NewRequiredWithout())
+	Register("required_with_all", NewRequiredWithAll())
+	Register("required_without_all", NewRequiredWithoutAll())
+	Register("excluded_if", NewExcludedIf())
+	Register("excluded_unless", NewExcludedUnless())
+	Register("excluded_with", NewExcludedWith())
 	Register("past", NewPast())
 	Register("future", NewFuture())
+	Register("after", NewAfter())
+	Register("before", NewBefore())
+	Register("within", NewWithin())
+	Register("weekday", NewWeekday())
+	Register("aftertime", NewAfterTime())
 	Register("url", NewURL())
 	Register("uri", NewURI())
 	Register("http_url", NewHTTPURL())
@@ -98,6 +146,13 @@ func init() {
 	Register("ipv4", NewIPv4())
 	Register("ipv6", NewIPv6())
 	Register("cidr", NewCIDR())
+	Register("ipin", NewIPIn())
+	Register("publicip", NewPublicIP())
+	Register("privateip", NewPrivateIP())
+	Register("loopback", NewLoopback())
+	Register("hostport", NewHostPort())
+	Register("tcpaddr", NewTCPAddr())
+	Register("ipversion", NewIPVersion())
 	Register("hostname", NewHostname())
 	Register("mac", NewMAC())
 	Register("port", NewPort())
@@ -105,8 +160,22 @@ func init() {
 	Register("slug", NewSlug())
 	Register("semver", NewSemver())
 	Register("json", NewJSON())
+	Register("jsonschema", NewJSONSchema())
+	Register("regexp", NewRegExp())
+	Register("file", NewFile())
+	Register("dir", NewDir())
+	Register("fileext", NewFileExt())
+	Register("readable", NewReadable())
+	Register("maxfilesize", NewMaxFileSize())
+	Register("timezone", NewTimezone())
+	Register("cron", NewCron())
+	Register("duration", NewDurationFormat())
+	Register("datetime", NewDatetime())
 	Register("choice", NewChoice())
 	RegisterAlias("oneof", "choice")
+	Register("choice_ci", NewCaseInsensitiveChoice())
+	RegisterAlias("oneof_ci", "choice_ci")
+	Register("enum", NewEnum())
 	RegisterAlias("gte", "ge")
 	RegisterAlias("lte", "le")
 	RegisterAlias("phone", "e164", "localPhone")
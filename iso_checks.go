@@ -0,0 +1,154 @@
+package govalidator
+
+import (
+	"strings"
+)
+
+// bcp47Irregular lists irregular grandfathered tags that do not follow BCP 47 syntax
+var bcp47Irregular = codeSet("en-gb-oed i-ami i-bnn i-default i-enochian i-hak i-klingon i-lux i-mingo i-navajo i-pwn i-tao i-tay i-tsu sgn-be-fr sgn-be-nl sgn-ch-de")
+
+// bcp47Regions lists BCP 47 registry regions that are not ISO 3166 countries
+var bcp47Regions = codeSet("EU EZ UN XK ZZ")
+
+// NewISO3166Alpha2 creates ISO 3166-1 alpha-2 country code check, i.e. US
+func NewISO3166Alpha2() func(field *Field, check *Check) (IsValid, error) {
+	return newCodeSetCheck("iso3166_alpha2", iso3166Alpha2)
+}
+
+// NewISO3166Alpha3 creates ISO 3166-1 alpha-3 country code check, i.e. USA
+func NewISO3166Alpha3() func(field *Field, check *Check) (IsValid, error) {
+	return newCodeSetCheck("iso3166_alpha3", iso3166Alpha3)
+}
+
+// NewISO3166Numeric creates ISO 3166-1 numeric country code check, i.e. 840
+func NewISO3166Numeric() func(field *Field, check *Check) (IsValid, error) {
+	return newCodeSetCheck("iso3166_numeric", iso3166Numeric)
+}
+
+// NewISO4217 creates ISO 4217 active currency code check, i.e. USD
+func NewISO4217() func(field *Field, check *Check) (IsValid, error) {
+	return newCodeSetCheck("iso4217", iso4217Codes)
+}
+
+// NewISO639Alpha2 creates ISO 639-1 language code check, i.e. en
+func NewISO639Alpha2() func(field *Field, check *Check) (IsValid, error) {
+	return newCodeSetCheck("iso639_1", iso639Alpha2)
+}
+
+// NewBCP47 creates BCP 47 language tag check, i.e. en-US or zh-Hant-TW
+func NewBCP47() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("bcp47", isBCP47)
+}
+
+func newCodeSetCheck(name string, codes map[string]bool) func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck(name, func(actual string) bool {
+		return codes[actual]
+	})
+}
+
+// isBCP47 returns true for well formed RFC 5646 language tag, two letter language and region subtags are checked with ISO datasets
+func isBCP47(actual string) bool {
+	if actual == "" {
+		return false
+	}
+	tag := strings.ToLower(actual)
+	if bcp47Irregular[tag] {
+		return true
+	}
+	subtags := strings.Split(tag, "-")
+	for _, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 || !isAlphaNumeric(subtag) {
+			return false
+		}
+	}
+	if subtags[0] == "x" {
+		return len(subtags) > 1
+	}
+	i := 0
+	language := subtags[i]
+	switch {
+	case len(language) == 2 && isAlpha(language):
+		if !iso639Alpha2[language] {
+			return false
+		}
+	case len(language) >= 3 && len(language) <= 8 && isAlpha(language):
+	default:
+		return false
+	}
+	i++
+	if len(language) <= 3 {
+		for extlang := 0; extlang < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]); extlang++ {
+			i++
+		}
+	}
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		i++
+	}
+	if i < len(subtags) {
+		region := subtags[i]
+		if len(region) == 2 && isAlpha(region) {
+			upper := strings.ToUpper(region)
+			if !iso3166Alpha2[upper] && !bcp47Regions[upper] {
+				return false
+			}
+			i++
+		} else if len(region) == 3 && isDigits(region) {
+			i++
+		}
+	}
+	variants := map[string]bool{}
+	for ; i < len(subtags) && isBCP47Variant(subtags[i]); i++ {
+		if variants[subtags[i]] {
+			return false
+		}
+		variants[subtags[i]] = true
+	}
+	singletons := map[string]bool{}
+	for i < len(subtags) {
+		singleton := subtags[i]
+		if len(singleton) != 1 {
+			return false
+		}
+		if singleton == "x" {
+			return i+1 < len(subtags)
+		}
+		if singletons[singleton] {
+			return false
+		}
+		singletons[singleton] = true
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 2 {
+			i++
+		}
+		if i == start {
+			return false
+		}
+	}
+	return true
+}
+
+func isBCP47Variant(subtag string) bool {
+	if len(subtag) >= 5 {
+		return true
+	}
+	return len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9'
+}
+
+func isAlpha(actual string) bool {
+	for i := 0; i < len(actual); i++ {
+		if c := actual[i]; !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphaNumeric(actual string) bool {
+	for i := 0; i < len(actual); i++ {
+		if c := actual[i]; !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
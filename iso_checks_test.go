@@ -0,0 +1,94 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_ISOChecks(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "countries",
+			input: struct {
+				Alpha2  string   `validate:"iso3166_alpha2"`
+				Alpha3  *string  `validate:"iso3166_alpha3"`
+				Numeric string   `validate:"iso3166_numeric"`
+				Targets []string `validate:"iso3166_alpha2"`
+			}{Alpha2: "US", Alpha3: stringPtr("DEU"), Numeric: "040", Targets: []string{"PL", "us", "XK", "GB"}},
+			expectPaths: []string{"Targets[1]", "Targets[2]"},
+		},
+		{
+			description: "invalid countries",
+			input: struct {
+				Alpha3  string `validate:"iso3166_alpha3"`
+				Numeric string `validate:"iso3166_numeric"`
+			}{Alpha3: "UK", Numeric: "40"},
+			expectPaths: []string{"Alpha3", "Numeric"},
+		},
+		{
+			description: "currencies",
+			input: struct {
+				Currency   string   `validate:"iso4217"`
+				Currencies []string `validate:"iso4217"`
+			}{Currency: "USD", Currencies: []string{"EUR", "XAU", "HRK", "usd"}},
+			expectPaths: []string{"Currencies[2]", "Currencies[3]"},
+		},
+		{
+			description: "languages",
+			input: struct {
+				Language  string  `validate:"iso639_1"`
+				Preferred *string `validate:"iso639_1"`
+			}{Language: "en", Preferred: stringPtr("EN")},
+			expectPaths: []string{"Preferred"},
+		},
+		{
+			description: "bcp47",
+			input: struct {
+				Tags []string `validate:"bcp47"`
+			}{Tags: []string{
+				"en", "en-US", "zh-Hant-TW", "es-419", "sr-Latn-RS", "de-CH-1996", "en-US-u-ca-gregory",
+				"zh-yue-HK", "x-private", "i-klingon", "en-a-bbb-x-a-ccc",
+				"", "en_US", "qq-US", "en-QQ", "en-US-", "de-1996-1996", "en-a-x-b", "en-a-foo-a-bar", "toolongtag",
+			}},
+			expectPaths: []string{"Tags[11]", "Tags[12]", "Tags[13]", "Tags[14]", "Tags[15]", "Tags[16]", "Tags[17]", "Tags[18]", "Tags[19]"},
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Country int `validate:"iso3166_numeric"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func TestDatasets(t *testing.T) {
+	assert.Len(t, iso3166Alpha2, 249)
+	assert.Len(t, iso3166Alpha3, 249)
+	assert.Len(t, iso3166Numeric, 249)
+	assert.Len(t, iso639Alpha2, 183)
+	assert.True(t, iso4217Codes["EUR"])
+}
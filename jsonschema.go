@@ -0,0 +1,881 @@
+package govalidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// maxJSONNumberExponent limits exponent of JSON numbers, numbers are compared exactly, so larger exponents would make comparisons expensive
+const maxJSONNumberExponent = 400
+
+// unsupportedSchemaKeywords lists draft 2020-12 assertions outside of supported subset, schemas using them are rejected instead of being partially applied
+var unsupportedSchemaKeywords = []string{"$dynamicRef", "$dynamicAnchor", "$recursiveRef", "unevaluatedProperties", "unevaluatedItems"}
+
+type (
+	//jsonSchema represents compiled JSON Schema (draft 2020-12 subset)
+	jsonSchema struct {
+		allowed              *bool
+		ref                  *jsonSchema
+		types                []string
+		enum                 []interface{}
+		constant             interface{}
+		hasConst             bool
+		minimum              *big.Rat
+		maximum              *big.Rat
+		exclusiveMinimum     *big.Rat
+		exclusiveMaximum     *big.Rat
+		multipleOf           *big.Rat
+		minLength            *int
+		maxLength            *int
+		pattern              *regexp.Regexp
+		prefixItems          []*jsonSchema
+		items                *jsonSchema
+		contains             *jsonSchema
+		minContains          *int
+		maxContains          *int
+		minItems             *int
+		maxItems             *int
+		uniqueItems          bool
+		properties           map[string]*jsonSchema
+		patternProperties    []*jsonPatternProperty
+		additionalProperties *jsonSchema
+		propertyNames        *jsonSchema
+		required             []string
+		dependentRequired    map[string][]string
+		dependentSchemas     map[string]*jsonSchema
+		minProperties        *int
+		maxProperties        *int
+		allOf                []*jsonSchema
+		anyOf                []*jsonSchema
+		oneOf                []*jsonSchema
+		not                  *jsonSchema
+		ifSchema             *jsonSchema
+		thenSchema           *jsonSchema
+		elseSchema           *jsonSchema
+	}
+
+	//jsonNumber represents JSON number parsed once on decoding
+	jsonNumber struct {
+		literal string
+		value   *big.Rat
+	}
+
+	jsonPatternProperty struct {
+		pattern *regexp.Regexp
+		schema  *jsonSchema
+	}
+
+	//jsonSchemaFailure represents payload value failing schema, path is relative to the payload root
+	jsonSchemaFailure struct {
+		path   *Path
+		value  interface{}
+		reason string
+	}
+
+	//jsonSchemaCompiler compiles schema document, subschemas are cached by JSON pointer, so recursive references are compiled once
+	jsonSchemaCompiler struct {
+		document interface{}
+		compiled map[string]*jsonSchema
+	}
+
+	jsonSchemaRegistry struct {
+		schemas map[string]*jsonSchema
+		sync.RWMutex
+	}
+)
+
+func (r *jsonSchemaRegistry) register(name string, schema *jsonSchema) {
+	r.Lock()
+	r.schemas[name] = schema
+	r.Unlock()
+}
+
+func (r *jsonSchemaRegistry) lookup(name string) *jsonSchema {
+	r.RLock()
+	ret := r.schemas[name]
+	r.RUnlock()
+	return ret
+}
+
+var _jsonSchemas = &jsonSchemaRegistry{schemas: map[string]*jsonSchema{}}
+
+// RegisterJSONSchema registers JSON Schema (draft 2020-12 subset) for jsonschema(name) check, schemas have to be registered before checks are built;
+// $ref takes local JSON pointer (#/$defs/node) or name of already registered schema
+func RegisterJSONSchema(name string, schema []byte) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("json schema name was empty")
+	}
+	document, err := decodeJSON(schema)
+	if err != nil {
+		return fmt.Errorf("invalid %v json schema: %w", name, err)
+	}
+	compiler := &jsonSchemaCompiler{document: document, compiled: map[string]*jsonSchema{}}
+	compiled, err := compiler.compile("")
+	if err == nil {
+		err = compiler.checkCycles()
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %v json schema: %w", name, err)
+	}
+	_jsonSchemas.register(name, compiled)
+	return nil
+}
+
+// NewJSONSchema creates check that JSON payload conforms to registered schema, violations are reported at payload value path nested under field path, i.e. jsonschema(schain)
+func NewJSONSchema() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		name := strings.TrimSpace(check.Parameters[0])
+		schema := _jsonSchemas.lookup(name)
+		if schema == nil {
+			return nil, NewInvalidParameterError(check, name, "expected registered json schema name", nil)
+		}
+		return newBytesValueCheck(field, check, func(ctx context.Context, actual []byte) (bool, error) {
+			payload, err := decodeJSON(actual)
+			if err != nil {
+				reportNested(ctx, NewPath(), nil, "invalid JSON: "+err.Error())
+				return false, nil
+			}
+			failures := schema.validate(payload, NewPath(), nil)
+			for _, failure := range failures {
+				reportNested(ctx, failure.path, failure.value, failure.reason)
+			}
+			return len(failures) == 0, nil
+		})
+	}
+}
+
+// String returns number literal
+func (n *jsonNumber) String() string {
+	return n.literal
+}
+
+// MarshalJSON returns number literal
+func (n *jsonNumber) MarshalJSON() ([]byte, error) {
+	return []byte(n.literal), nil
+}
+
+// decodeJSON decodes single JSON value, numbers are decoded as *jsonNumber to keep exact value
+func decodeJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var ret interface{}
+	if err := decoder.Decode(&ret); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return parseJSONNumbers(ret)
+}
+
+// parseJSONNumbers replaces json.Number values with parsed *jsonNumber
+func parseJSONNumbers(value interface{}) (interface{}, error) {
+	var err error
+	switch actual := value.(type) {
+	case json.Number:
+		return parseJSONNumber(actual)
+	case []interface{}:
+		for i, item := range actual {
+			if actual[i], err = parseJSONNumbers(item); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for key, item := range actual {
+			if actual[key], err = parseJSONNumbers(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return value, nil
+}
+
+func parseJSONNumber(number json.Number) (*jsonNumber, error) {
+	literal := string(number)
+	if index := strings.IndexAny(literal, "eE"); index != -1 {
+		exponent, err := strconv.Atoi(strings.TrimPrefix(literal[index+1:], "+"))
+		if err != nil || exponent > maxJSONNumberExponent || exponent < -maxJSONNumberExponent {
+			return nil, fmt.Errorf("number %v exponent out of supported range", literal)
+		}
+	}
+	value, ok := new(big.Rat).SetString(literal)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %v", literal)
+	}
+	return &jsonNumber{literal: literal, value: value}, nil
+}
+
+func (c *jsonSchemaCompiler) compile(pointer string) (*jsonSchema, error) {
+	if ret, ok := c.compiled[pointer]; ok {
+		return ret, nil
+	}
+	node, err := resolveJSONPointer(c.document, pointer)
+	if err != nil {
+		return nil, err
+	}
+	ret := &jsonSchema{}
+	c.compiled[pointer] = ret
+	switch actual := node.(type) {
+	case bool:
+		ret.allowed = &actual
+		return ret, nil
+	case map[string]interface{}:
+		if err = c.compileKeywords(ret, actual, pointer); err != nil {
+			return nil, err
+		}
+		return ret, nil
+	}
+	return nil, fmt.Errorf("%v: expected object or boolean schema", schemaLocation(pointer))
+}
+
+func (c *jsonSchemaCompiler) compileKeywords(s *jsonSchema, node map[string]interface{}, pointer string) (err error) {
+	for _, keyword := range unsupportedSchemaKeywords {
+		if _, ok := node[keyword]; ok {
+			return fmt.Errorf("%v: unsupported keyword %v", schemaLocation(pointer), keyword)
+		}
+	}
+	if ref, ok := node["$ref"]; ok {
+		if s.ref, err = c.compileRef(ref, pointer); err != nil {
+			return err
+		}
+	}
+	if value, ok := node["type"]; ok {
+		if s.types, err = schemaStrings(value, pointer+"/type"); err != nil {
+			return err
+		}
+		for _, name := range s.types {
+			switch name {
+			case "null", "boolean", "object", "array", "number", "integer", "string":
+			default:
+				return fmt.Errorf("%v: unknown type %v", schemaLocation(pointer+"/type"), name)
+			}
+		}
+	}
+	if value, ok := node["enum"]; ok {
+		if s.enum, ok = value.([]interface{}); !ok {
+			return fmt.Errorf("%v: expected array", schemaLocation(pointer+"/enum"))
+		}
+	}
+	s.constant, s.hasConst = node["const"]
+	for keyword, target := range map[string]**big.Rat{"minimum": &s.minimum, "maximum": &s.maximum, "exclusiveMinimum": &s.exclusiveMinimum, "exclusiveMaximum": &s.exclusiveMaximum, "multipleOf": &s.multipleOf} {
+		if value, ok := node[keyword]; ok {
+			if *target, err = schemaNumber(value, pointer+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+	if s.multipleOf != nil && s.multipleOf.Sign() <= 0 {
+		return fmt.Errorf("%v: expected positive number", schemaLocation(pointer+"/multipleOf"))
+	}
+	for keyword, target := range map[string]**int{"minLength": &s.minLength, "maxLength": &s.maxLength, "minItems": &s.minItems, "maxItems": &s.maxItems, "minContains": &s.minContains, "maxContains": &s.maxContains, "minProperties": &s.minProperties, "maxProperties": &s.maxProperties} {
+		if value, ok := node[keyword]; ok {
+			if *target, err = schemaCount(value, pointer+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+	if value, ok := node["pattern"]; ok {
+		if s.pattern, err = schemaPattern(value, pointer+"/pattern"); err != nil {
+			return err
+		}
+	}
+	if value, ok := node["uniqueItems"]; ok {
+		if s.uniqueItems, ok = value.(bool); !ok {
+			return fmt.Errorf("%v: expected boolean", schemaLocation(pointer+"/uniqueItems"))
+		}
+	}
+	if value, ok := node["required"]; ok {
+		if s.required, err = schemaStrings(value, pointer+"/required"); err != nil {
+			return err
+		}
+	}
+	if value, ok := node["dependentRequired"]; ok {
+		dependencies, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v: expected object", schemaLocation(pointer+"/dependentRequired"))
+		}
+		s.dependentRequired = map[string][]string{}
+		for name, required := range dependencies {
+			if s.dependentRequired[name], err = schemaStrings(required, pointer+"/dependentRequired/"+escapeJSONPointer(name)); err != nil {
+				return err
+			}
+		}
+	}
+	for keyword, target := range map[string]**jsonSchema{"items": &s.items, "contains": &s.contains, "additionalProperties": &s.additionalProperties, "propertyNames": &s.propertyNames, "not": &s.not, "if": &s.ifSchema, "then": &s.thenSchema, "else": &s.elseSchema} {
+		if _, ok := node[keyword]; ok {
+			if *target, err = c.compile(pointer + "/" + keyword); err != nil {
+				return err
+			}
+		}
+	}
+	for keyword, target := range map[string]*[]*jsonSchema{"prefixItems": &s.prefixItems, "allOf": &s.allOf, "anyOf": &s.anyOf, "oneOf": &s.oneOf} {
+		if value, ok := node[keyword]; ok {
+			if *target, err = c.compileArray(value, pointer+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+	for keyword, target := range map[string]*map[string]*jsonSchema{"properties": &s.properties, "dependentSchemas": &s.dependentSchemas} {
+		if value, ok := node[keyword]; ok {
+			if *target, err = c.compileObject(value, pointer+"/"+keyword); err != nil {
+				return err
+			}
+		}
+	}
+	if value, ok := node["patternProperties"]; ok {
+		patterns, err := c.compileObject(value, pointer+"/patternProperties")
+		if err != nil {
+			return err
+		}
+		for _, expr := range sortedSchemaKeys(patterns) {
+			pattern, err := schemaPattern(expr, pointer+"/patternProperties")
+			if err != nil {
+				return err
+			}
+			s.patternProperties = append(s.patternProperties, &jsonPatternProperty{pattern: pattern, schema: patterns[expr]})
+		}
+	}
+	return nil
+}
+
+// checkCycles returns error if subschemas applied to the same payload value ($ref and in place applicators) form a cycle,
+// such schema would recurse without descending into the payload
+func (c *jsonSchemaCompiler) checkCycles() error {
+	pointers := make(map[*jsonSchema]string, len(c.compiled))
+	for pointer, schema := range c.compiled {
+		pointers[schema] = pointer
+	}
+	const visiting, visited = 1, 2
+	state := map[*jsonSchema]int{}
+	var visit func(schema *jsonSchema) error
+	visit = func(schema *jsonSchema) error {
+		pointer, local := pointers[schema]
+		if !local || state[schema] == visited {
+			return nil
+		}
+		if state[schema] == visiting {
+			return fmt.Errorf("%v: $ref cycle does not descend into payload", schemaLocation(pointer))
+		}
+		state[schema] = visiting
+		for _, next := range schema.inPlaceSchemas() {
+			if err := visit(next); err != nil {
+				return err
+			}
+		}
+		state[schema] = visited
+		return nil
+	}
+	sorted := make([]string, 0, len(c.compiled))
+	for pointer := range c.compiled {
+		sorted = append(sorted, pointer)
+	}
+	sort.Strings(sorted)
+	for _, pointer := range sorted {
+		if err := visit(c.compiled[pointer]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compileRef compiles local JSON pointer reference or returns registered schema
+func (c *jsonSchemaCompiler) compileRef(value interface{}, pointer string) (*jsonSchema, error) {
+	ref, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%v: expected string", schemaLocation(pointer+"/$ref"))
+	}
+	if strings.HasPrefix(ref, "#") {
+		return c.compile(ref[1:])
+	}
+	if ret := _jsonSchemas.lookup(ref); ret != nil {
+		return ret, nil
+	}
+	return nil, fmt.Errorf("%v: unknown schema %v", schemaLocation(pointer+"/$ref"), ref)
+}
+
+func (c *jsonSchemaCompiler) compileArray(value interface{}, pointer string) ([]*jsonSchema, error) {
+	items, ok := value.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("%v: expected non empty array", schemaLocation(pointer))
+	}
+	var ret = make([]*jsonSchema, len(items))
+	for i := range items {
+		schema, err := c.compile(pointer + "/" + strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = schema
+	}
+	return ret, nil
+}
+
+func (c *jsonSchemaCompiler) compileObject(value interface{}, pointer string) (map[string]*jsonSchema, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v: expected object", schemaLocation(pointer))
+	}
+	var ret = make(map[string]*jsonSchema, len(object))
+	for name := range object {
+		schema, err := c.compile(pointer + "/" + escapeJSONPointer(name))
+		if err != nil {
+			return nil, err
+		}
+		ret[name] = schema
+	}
+	return ret, nil
+}
+
+// validate appends failures of instance located at path
+func (s *jsonSchema) validate(instance interface{}, path *Path, failures []*jsonSchemaFailure) []*jsonSchemaFailure {
+	if s.allowed != nil {
+		if !*s.allowed {
+			failures = append(failures, &jsonSchemaFailure{path: path, value: instance, reason: "value is not allowed"})
+		}
+		return failures
+	}
+	if s.ref != nil {
+		failures = s.ref.validate(instance, path, failures)
+	}
+	if len(s.types) > 0 && !jsonTypeMatches(s.types, instance) {
+		return append(failures, &jsonSchemaFailure{path: path, value: instance, reason: "expected type " + strings.Join(s.types, " or ")})
+	}
+	if s.hasConst && !jsonEqual(s.constant, instance) {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: instance, reason: "expected constant value"})
+	}
+	if s.enum != nil && !jsonContains(s.enum, instance) {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: instance, reason: "expected one of enumerated values"})
+	}
+	switch actual := instance.(type) {
+	case *jsonNumber:
+		failures = s.validateNumber(actual, path, failures)
+	case string:
+		failures = s.validateString(actual, path, failures)
+	case []interface{}:
+		failures = s.validateArray(actual, path, failures)
+	case map[string]interface{}:
+		failures = s.validateObject(actual, path, failures)
+	}
+	for _, schema := range s.allOf {
+		failures = schema.validate(instance, path, failures)
+	}
+	if len(s.anyOf) > 0 && countMatching(s.anyOf, instance) == 0 {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: instance, reason: "expected value matching at least one schema"})
+	}
+	if len(s.oneOf) > 0 && countMatching(s.oneOf, instance) != 1 {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: instance, reason: "expected value matching exactly one schema"})
+	}
+	if s.not != nil && s.not.matches(instance) {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: instance, reason: "expected value not matching schema"})
+	}
+	if s.ifSchema != nil {
+		if s.ifSchema.matches(instance) {
+			if s.thenSchema != nil {
+				failures = s.thenSchema.validate(instance, path, failures)
+			}
+		} else if s.elseSchema != nil {
+			failures = s.elseSchema.validate(instance, path, failures)
+		}
+	}
+	return failures
+}
+
+// inPlaceSchemas returns subschemas applied to the same payload value as the schema
+func (s *jsonSchema) inPlaceSchemas() []*jsonSchema {
+	var ret []*jsonSchema
+	for _, schema := range []*jsonSchema{s.ref, s.not, s.ifSchema, s.thenSchema, s.elseSchema} {
+		if schema != nil {
+			ret = append(ret, schema)
+		}
+	}
+	ret = append(ret, s.allOf...)
+	ret = append(ret, s.anyOf...)
+	ret = append(ret, s.oneOf...)
+	for _, name := range sortedSchemaKeys(s.dependentSchemas) {
+		ret = append(ret, s.dependentSchemas[name])
+	}
+	return ret
+}
+
+func (s *jsonSchema) matches(instance interface{}) bool {
+	return len(s.validate(instance, NewPath(), nil)) == 0
+}
+
+func (s *jsonSchema) validateNumber(actual *jsonNumber, path *Path, failures []*jsonSchemaFailure) []*jsonSchemaFailure {
+	value := actual.value
+	if s.minimum != nil && value.Cmp(s.minimum) < 0 {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: "expected value >= " + s.minimum.RatString()})
+	}
+	if s.maximum != nil && value.Cmp(s.maximum) > 0 {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: "expected value <= " + s.maximum.RatString()})
+	}
+	if s.exclusiveMinimum != nil && value.Cmp(s.exclusiveMinimum) <= 0 {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: "expected value > " + s.exclusiveMinimum.RatString()})
+	}
+	if s.exclusiveMaximum != nil && value.Cmp(s.exclusiveMaximum) >= 0 {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: "expected value < " + s.exclusiveMaximum.RatString()})
+	}
+	if s.multipleOf != nil && !new(big.Rat).Quo(value, s.multipleOf).IsInt() {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: "expected multiple of " + s.multipleOf.RatString()})
+	}
+	return failures
+}
+
+func (s *jsonSchema) validateString(actual string, path *Path, failures []*jsonSchemaFailure) []*jsonSchemaFailure {
+	length := utf8.RuneCountInString(actual)
+	if s.minLength != nil && length < *s.minLength {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at least %v characters", *s.minLength)})
+	}
+	if s.maxLength != nil && length > *s.maxLength {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at most %v characters", *s.maxLength)})
+	}
+	if s.pattern != nil && !s.pattern.MatchString(actual) {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: "expected value matching pattern " + s.pattern.String()})
+	}
+	return failures
+}
+
+func (s *jsonSchema) validateArray(actual []interface{}, path *Path, failures []*jsonSchemaFailure) []*jsonSchemaFailure {
+	if s.minItems != nil && len(actual) < *s.minItems {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at least %v items", *s.minItems)})
+	}
+	if s.maxItems != nil && len(actual) > *s.maxItems {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at most %v items", *s.maxItems)})
+	}
+	var seen map[string]bool
+	if s.uniqueItems {
+		seen = make(map[string]bool, len(actual))
+	}
+	for i, item := range actual {
+		if i < len(s.prefixItems) {
+			failures = s.prefixItems[i].validate(item, path.Element(i), failures)
+		} else if s.items != nil {
+			failures = s.items.validate(item, path.Element(i), failures)
+		}
+		if seen != nil {
+			key := jsonKey(item)
+			if seen[key] {
+				failures = append(failures, &jsonSchemaFailure{path: path.Element(i), value: item, reason: "expected unique items"})
+			}
+			seen[key] = true
+		}
+	}
+	if s.contains != nil {
+		matching := countMatchingItems(s.contains, actual)
+		minContains := 1
+		if s.minContains != nil {
+			minContains = *s.minContains
+		}
+		if matching < minContains {
+			failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at least %v matching items", minContains)})
+		}
+		if s.maxContains != nil && matching > *s.maxContains {
+			failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at most %v matching items", *s.maxContains)})
+		}
+	}
+	return failures
+}
+
+func (s *jsonSchema) validateObject(actual map[string]interface{}, path *Path, failures []*jsonSchemaFailure) []*jsonSchemaFailure {
+	if s.minProperties != nil && len(actual) < *s.minProperties {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at least %v properties", *s.minProperties)})
+	}
+	if s.maxProperties != nil && len(actual) > *s.maxProperties {
+		failures = append(failures, &jsonSchemaFailure{path: path, value: actual, reason: fmt.Sprintf("expected at most %v properties", *s.maxProperties)})
+	}
+	for _, name := range s.required {
+		if _, ok := actual[name]; !ok {
+			failures = append(failures, &jsonSchemaFailure{path: path.Field(name), reason: "required property"})
+		}
+	}
+	names := make([]string, 0, len(actual))
+	for name := range actual {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := actual[name]
+		if required, ok := s.dependentRequired[name]; ok {
+			for _, dependency := range required {
+				if _, ok := actual[dependency]; !ok {
+					failures = append(failures, &jsonSchemaFailure{path: path.Field(dependency), reason: "property required by " + name})
+				}
+			}
+		}
+		if schema, ok := s.dependentSchemas[name]; ok {
+			failures = schema.validate(actual, path, failures)
+		}
+		if s.propertyNames != nil && !s.propertyNames.matches(name) {
+			failures = append(failures, &jsonSchemaFailure{path: path.Field(name), value: name, reason: "invalid property name"})
+		}
+		evaluated := false
+		if schema, ok := s.properties[name]; ok {
+			failures = schema.validate(value, path.Field(name), failures)
+			evaluated = true
+		}
+		for _, property := range s.patternProperties {
+			if property.pattern.MatchString(name) {
+				failures = property.schema.validate(value, path.Field(name), failures)
+				evaluated = true
+			}
+		}
+		if !evaluated && s.additionalProperties != nil {
+			if s.additionalProperties.allowed != nil && !*s.additionalProperties.allowed {
+				failures = append(failures, &jsonSchemaFailure{path: path.Field(name), value: value, reason: "property is not allowed"})
+				continue
+			}
+			failures = s.additionalProperties.validate(value, path.Field(name), failures)
+		}
+	}
+	return failures
+}
+
+func countMatching(schemas []*jsonSchema, instance interface{}) int {
+	ret := 0
+	for _, schema := range schemas {
+		if schema.matches(instance) {
+			ret++
+		}
+	}
+	return ret
+}
+
+func countMatchingItems(schema *jsonSchema, items []interface{}) int {
+	ret := 0
+	for _, item := range items {
+		if schema.matches(item) {
+			ret++
+		}
+	}
+	return ret
+}
+
+func jsonTypeMatches(types []string, instance interface{}) bool {
+	for _, name := range types {
+		switch actual := instance.(type) {
+		case nil:
+			if name == "null" {
+				return true
+			}
+		case bool:
+			if name == "boolean" {
+				return true
+			}
+		case string:
+			if name == "string" {
+				return true
+			}
+		case []interface{}:
+			if name == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if name == "object" {
+				return true
+			}
+		case *jsonNumber:
+			if name == "number" || (name == "integer" && actual.value.IsInt()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jsonEqual compares decoded JSON values, numbers are compared by value, i.e. 1 equals 1.0
+func jsonEqual(left, right interface{}) bool {
+	switch actual := left.(type) {
+	case *jsonNumber:
+		other, ok := right.(*jsonNumber)
+		return ok && actual.value.Cmp(other.value) == 0
+	case []interface{}:
+		other, ok := right.([]interface{})
+		if !ok || len(actual) != len(other) {
+			return false
+		}
+		for i := range actual {
+			if !jsonEqual(actual[i], other[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		other, ok := right.(map[string]interface{})
+		if !ok || len(actual) != len(other) {
+			return false
+		}
+		for key, value := range actual {
+			otherValue, ok := other[key]
+			if !ok || !jsonEqual(value, otherValue) {
+				return false
+			}
+		}
+		return true
+	}
+	switch right.(type) {
+	case *jsonNumber, []interface{}, map[string]interface{}:
+		return false
+	}
+	return left == right
+}
+
+// jsonKey returns canonical representation of decoded JSON value, equal values (see jsonEqual) have equal keys
+func jsonKey(value interface{}) string {
+	builder := new(strings.Builder)
+	writeJSONKey(builder, value)
+	return builder.String()
+}
+
+func writeJSONKey(builder *strings.Builder, value interface{}) {
+	switch actual := value.(type) {
+	case nil:
+		builder.WriteString("null")
+	case bool:
+		builder.WriteString(strconv.FormatBool(actual))
+	case string:
+		builder.WriteString(strconv.Quote(actual))
+	case *jsonNumber:
+		builder.WriteString(actual.value.RatString())
+	case []interface{}:
+		builder.WriteByte('[')
+		for i, item := range actual {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+			writeJSONKey(builder, item)
+		}
+		builder.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(actual))
+		for key := range actual {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		builder.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+			builder.WriteString(strconv.Quote(key))
+			builder.WriteByte(':')
+			writeJSONKey(builder, actual[key])
+		}
+		builder.WriteByte('}')
+	}
+}
+
+func jsonContains(values []interface{}, instance interface{}) bool {
+	for _, value := range values {
+		if jsonEqual(value, instance) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveJSONPointer returns RFC 6901 pointer target, empty pointer refers to the whole document
+func resolveJSONPointer(document interface{}, pointer string) (interface{}, error) {
+	if pointer == "" {
+		return document, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid json pointer: %v", pointer)
+	}
+	node := document
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		switch actual := node.(type) {
+		case map[string]interface{}:
+			value, ok := actual[token]
+			if !ok {
+				return nil, fmt.Errorf("unresolved json pointer: %v", pointer)
+			}
+			node = value
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(actual) {
+				return nil, fmt.Errorf("unresolved json pointer: %v", pointer)
+			}
+			node = actual[index]
+		default:
+			return nil, fmt.Errorf("unresolved json pointer: %v", pointer)
+		}
+	}
+	return node, nil
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+func schemaLocation(pointer string) string {
+	return "#" + pointer
+}
+
+func sortedSchemaKeys(schemas map[string]*jsonSchema) []string {
+	ret := make([]string, 0, len(schemas))
+	for key := range schemas {
+		ret = append(ret, key)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func schemaStrings(value interface{}, pointer string) ([]string, error) {
+	if text, ok := value.(string); ok {
+		return []string{text}, nil
+	}
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%v: expected array of strings", schemaLocation(pointer))
+	}
+	var ret = make([]string, len(items))
+	for i, item := range items {
+		if ret[i], ok = item.(string); !ok {
+			return nil, fmt.Errorf("%v: expected array of strings", schemaLocation(pointer))
+		}
+	}
+	return ret, nil
+}
+
+func schemaNumber(value interface{}, pointer string) (*big.Rat, error) {
+	number, ok := value.(*jsonNumber)
+	if !ok {
+		return nil, fmt.Errorf("%v: expected number", schemaLocation(pointer))
+	}
+	return number.value, nil
+}
+
+func schemaCount(value interface{}, pointer string) (*int, error) {
+	number, err := schemaNumber(value, pointer)
+	if err != nil || !number.IsInt() || number.Sign() < 0 || !number.Num().IsInt64() {
+		return nil, fmt.Errorf("%v: expected non negative integer", schemaLocation(pointer))
+	}
+	ret := int(number.Num().Int64())
+	return &ret, nil
+}
+
+func schemaPattern(value interface{}, pointer string) (*regexp.Regexp, error) {
+	expr, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%v: expected regular expression", schemaLocation(pointer))
+	}
+	ret, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", schemaLocation(pointer), err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,236 @@
+package govalidator
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_JSONSchema(t *testing.T) {
+	if !assert.Nil(t, RegisterJSONSchema("test_schain_node", []byte(`{
+		"type": "object",
+		"required": ["asi", "sid", "hp"],
+		"properties": {
+			"asi": {"type": "string", "pattern": "^[a-z0-9.-]+\\.[a-z]+$"},
+			"sid": {"type": "string", "minLength": 1},
+			"hp": {"const": 1}
+		}
+	}`))) {
+		return
+	}
+	if !assert.Nil(t, RegisterJSONSchema("test_ext", []byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"schain": {
+				"type": "object",
+				"required": ["ver", "complete", "nodes"],
+				"additionalProperties": false,
+				"properties": {
+					"ver": {"enum": ["1.0"]},
+					"complete": {"type": "integer", "minimum": 0, "maximum": 1},
+					"nodes": {"type": "array", "minItems": 1, "items": {"$ref": "test_schain_node"}}
+				}
+			},
+			"tags": {"type": "array", "items": {"type": "string"}, "uniqueItems": true},
+			"bidfloor": {"type": "number", "exclusiveMinimum": 0, "multipleOf": 0.01},
+			"tree": {"$ref": "#/$defs/tree"}
+		},
+		"$defs": {
+			"tree": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string"},
+					"children": {"type": "array", "items": {"$ref": "#/$defs/tree"}}
+				},
+				"required": ["name"]
+			}
+		}
+	}`))) {
+		return
+	}
+
+	var testCases = []struct {
+		description   string
+		input         interface{}
+		expectPaths   []string
+		expectMessage string
+		expectErr     bool
+	}{
+		{
+			description: "valid payload",
+			input: struct {
+				Ext string `validate:"jsonschema(test_ext)"`
+			}{Ext: `{"schain":{"ver":"1.0","complete":1,"nodes":[{"asi":"exchange.com","sid":"1","hp":1}]},"bidfloor":1.25,"tree":{"name":"a","children":[{"name":"b"}]}}`},
+		},
+		{
+			description: "nested violations",
+			input: struct {
+				Ext string `validate:"jsonschema(test_ext)"`
+			}{Ext: `{"schain":{"ver":"2.0","complete":1.0,"nodes":[{"asi":"Exchange","sid":"","hp":1},{"sid":"2","hp":2}],"ext":{}},"tags":["a","b","a"],"bidfloor":0.125,"tree":{"children":[{"name":3}]}}`},
+			expectPaths: []string{
+				"Ext.bidfloor",
+				"Ext.schain.ext",
+				"Ext.schain.nodes[0].asi",
+				"Ext.schain.nodes[0].sid",
+				"Ext.schain.nodes[1].asi",
+				"Ext.schain.nodes[1].hp",
+				"Ext.schain.ver",
+				"Ext.tags[2]",
+				"Ext.tree.name",
+				"Ext.tree.children[0].name",
+			},
+		},
+		{
+			description: "required property message",
+			input: struct {
+				Ext []byte `validate:"jsonschema(test_schain_node)"`
+			}{Ext: []byte(`{"asi":"a.com","hp":1}`)},
+			expectPaths:   []string{"Ext.sid"},
+			expectMessage: "required property",
+		},
+		{
+			description: "raw message, pointer and slice elements",
+			input: struct {
+				Raw   json.RawMessage `validate:"jsonschema(test_schain_node)"`
+				Ptr   *string         `validate:"jsonschema(test_schain_node)"`
+				Nodes []string        `validate:"jsonschema(test_schain_node)"`
+			}{Raw: json.RawMessage(`[]`), Ptr: stringPtr(`{"asi":"a.com","sid":"1","hp":1}`), Nodes: []string{`{"asi":"a.com","sid":"1","hp":1}`, `{"asi":"a.com","sid":"1"}`}},
+			expectPaths: []string{"Raw", "Nodes[1].hp"},
+		},
+		{
+			description: "invalid JSON",
+			input: struct {
+				Ext string `validate:"jsonschema(test_ext)"`
+			}{Ext: `{"schain":`},
+			expectPaths: []string{"Ext"},
+		},
+		{
+			description: "custom message",
+			input: struct {
+				Ext string `validate:"jsonschema(test_schain_node),message=invalid node"`
+			}{Ext: `{}`},
+			expectPaths:   []string{"Ext.asi", "Ext.sid", "Ext.hp"},
+			expectMessage: "invalid node",
+		},
+		{
+			description: "unknown schema",
+			input: struct {
+				Ext string `validate:"jsonschema(test_missing)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Ext int `validate:"jsonschema(test_ext)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+			if testCase.expectMessage != "" {
+				assert.EqualValues(t, testCase.expectMessage, violation.Message, testCase.description)
+			}
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func TestJSONSchema_Keywords(t *testing.T) {
+	var testCases = []struct {
+		description string
+		schema      string
+		valid       []string
+		invalid     []string
+	}{
+		{description: "boolean schema", schema: `false`, invalid: []string{`1`, `{}`}},
+		{description: "type list", schema: `{"type":["string","null"]}`, valid: []string{`"a"`, `null`}, invalid: []string{`1`, `{}`}},
+		{description: "integer", schema: `{"type":"integer"}`, valid: []string{`1`, `1.0`, `1e3`}, invalid: []string{`1.5`, `"1"`}},
+		{description: "exact numbers", schema: `{"multipleOf":0.1,"maximum":0.3}`, valid: []string{`0.3`, `0.2`}, invalid: []string{`0.31`, `0.35`}},
+		{description: "const and enum", schema: `{"enum":[{"a":[1,2]},"x"]}`, valid: []string{`{"a":[1.0,2]}`, `"x"`}, invalid: []string{`{"a":[2,1]}`, `"y"`}},
+		{description: "string length in code points", schema: `{"minLength":2,"maxLength":3}`, valid: []string{`"żó"`, `1`}, invalid: []string{`"ż"`, `"żółw"`}},
+		{description: "prefix items", schema: `{"prefixItems":[{"type":"string"}],"items":{"type":"integer"}}`, valid: []string{`["a",1,2]`}, invalid: []string{`[1]`, `["a","b"]`}},
+		{description: "contains", schema: `{"contains":{"const":1},"maxContains":2}`, valid: []string{`[1,2]`, `[1,1]`}, invalid: []string{`[2]`, `[1,1,1]`}},
+		{description: "pattern and additional properties", schema: `{"patternProperties":{"^x_":{"type":"string"}},"additionalProperties":{"type":"integer"}}`, valid: []string{`{"x_a":"b","c":1}`}, invalid: []string{`{"x_a":1}`, `{"c":"d"}`}},
+		{description: "property names and count", schema: `{"propertyNames":{"maxLength":2},"maxProperties":2}`, valid: []string{`{"ab":1}`}, invalid: []string{`{"abc":1}`, `{"a":1,"b":2,"c":3}`}},
+		{description: "dependent required", schema: `{"dependentRequired":{"card":["cvv"]}}`, valid: []string{`{"card":"1","cvv":"2"}`, `{}`}, invalid: []string{`{"card":"1"}`}},
+		{description: "combinators", schema: `{"anyOf":[{"type":"string"},{"type":"integer"}],"oneOf":[{"minimum":0},{"maximum":10}],"not":{"const":5}}`, valid: []string{`-1`, `11`}, invalid: []string{`5`, `3`, `1.5`, `"a"`}},
+		{description: "if then else", schema: `{"if":{"properties":{"type":{"const":"app"}}},"then":{"required":["bundle"]},"else":{"required":["domain"]}}`, valid: []string{`{"type":"app","bundle":"a"}`, `{"type":"site","domain":"a"}`}, invalid: []string{`{"type":"app"}`, `{}`}},
+	}
+	for _, testCase := range testCases {
+		compiler := &jsonSchemaCompiler{compiled: map[string]*jsonSchema{}}
+		var err error
+		if compiler.document, err = decodeJSON([]byte(testCase.schema)); !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		schema, err := compiler.compile("")
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		for _, payload := range testCase.valid {
+			instance, _ := decodeJSON([]byte(payload))
+			assert.True(t, schema.matches(instance), testCase.description+": "+payload)
+		}
+		for _, payload := range testCase.invalid {
+			instance, _ := decodeJSON([]byte(payload))
+			assert.False(t, schema.matches(instance), testCase.description+": "+payload)
+		}
+	}
+}
+
+func TestRegisterJSONSchema(t *testing.T) {
+	assert.NotNil(t, RegisterJSONSchema("test_invalid", []byte(`{"type":"text"}`)))
+	assert.NotNil(t, RegisterJSONSchema("test_invalid", []byte(`{"$ref":"#/$defs/missing"}`)))
+	assert.NotNil(t, RegisterJSONSchema("test_invalid", []byte(`{"unevaluatedProperties":false}`)))
+	assert.NotNil(t, RegisterJSONSchema("test_invalid", []byte(`{"pattern":"("}`)))
+	assert.NotNil(t, RegisterJSONSchema("", []byte(`{}`)))
+	assert.NotNil(t, RegisterJSONSchema("test_invalid", []byte(`{"$defs":{"a":{"$ref":"#/$defs/b"},"b":{"$ref":"#/$defs/a"}},"$ref":"#/$defs/a"}`)))
+	assert.NotNil(t, RegisterJSONSchema("test_invalid", []byte(`{"type":"object","allOf":[{"$ref":"#"}]}`)))
+	assert.NotNil(t, RegisterJSONSchema("test_invalid", []byte(`{"minimum":1e1000000}`)))
+	assert.Nil(t, RegisterJSONSchema("test_any", []byte(`true`)))
+}
+
+func TestJSONSchema_Limits(t *testing.T) {
+	if !assert.Nil(t, RegisterJSONSchema("test_unique", []byte(`{"uniqueItems":true}`))) {
+		return
+	}
+	items := make([]string, 5000)
+	for i := range items {
+		items[i] = strconv.Itoa(i)
+	}
+	input := struct {
+		Items string `validate:"jsonschema(test_unique)"`
+		Dup   string `validate:"jsonschema(test_unique)"`
+		Large string `validate:"jsonschema(test_unique)"`
+	}{Items: "[" + strings.Join(items, ",") + "]", Dup: `[1,{"a":[1]},1.0,{"a":[1.00]}]`, Large: `[1e1000000]`}
+	started := time.Now()
+	validation, err := New().Validate(context.Background(), input)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.Less(t, int64(time.Since(started)), int64(time.Second))
+	var paths []string
+	for _, violation := range validation.Violations {
+		paths = append(paths, violation.Location)
+	}
+	assert.EqualValues(t, []string{"Dup[2]", "Dup[3]", "Large"}, paths)
+	assert.Nil(t, RegisterJSONSchema("test_recursive", []byte(`{"properties":{"next":{"$ref":"#"}},"anyOf":[{"$ref":"#/$defs/leaf"}],"$defs":{"leaf":{"type":"object"}}}`)))
+}
@@ -0,0 +1,195 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+type mapCheck struct {
+	count   int
+	keys    []string
+	allowed map[string]bool
+	pattern *regexp.Regexp
+}
+
+func (m *mapCheck) minKeys(ctx context.Context, value interface{}) (bool, error) {
+	mapValue, isNil := derefReflectValue(value)
+	if isNil {
+		return m.count <= 0, nil
+	}
+	return mapValue.Len() >= m.count, nil
+}
+
+func (m *mapCheck) maxKeys(ctx context.Context, value interface{}) (bool, error) {
+	mapValue, isNil := derefReflectValue(value)
+	if isNil {
+		return true, nil
+	}
+	return mapValue.Len() <= m.count, nil
+}
+
+func (m *mapCheck) hasKeys(ctx context.Context, value interface{}) (bool, error) {
+	mapValue, isNil := derefReflectValue(value)
+	present := map[string]bool{}
+	if !isNil {
+		_, names := sortedMapKeys(mapValue)
+		for _, name := range names {
+			present[name] = true
+		}
+	}
+	passed := true
+	for _, key := range m.keys {
+		if !present[key] {
+			reportEntry(ctx, key, nil)
+			passed = false
+		}
+	}
+	return passed, nil
+}
+
+func (m *mapCheck) keysPattern(ctx context.Context, value interface{}) (bool, error) {
+	return m.checkKeys(ctx, value, func(name string) bool {
+		return m.pattern.MatchString(name)
+	})
+}
+
+func (m *mapCheck) allowKeys(ctx context.Context, value interface{}) (bool, error) {
+	return m.checkKeys(ctx, value, func(name string) bool {
+		return m.allowed[name]
+	})
+}
+
+// checkKeys reports entry of each key that does not satisfy predicate
+func (m *mapCheck) checkKeys(ctx context.Context, value interface{}, predicate func(name string) bool) (bool, error) {
+	mapValue, isNil := derefReflectValue(value)
+	if isNil {
+		return true, nil
+	}
+	passed := true
+	keys, names := sortedMapKeys(mapValue)
+	for i, name := range names {
+		if !predicate(name) {
+			reportEntry(ctx, name, mapValue.MapIndex(keys[i]).Interface())
+			passed = false
+		}
+	}
+	return passed, nil
+}
+
+// NewMinKeys creates minimum map entries count check, i.e. minkeys(1)
+func NewMinKeys() func(field *Field, check *Check) (IsValid, error) {
+	return newMapCountCheck(func(m *mapCheck) IsValid {
+		return m.minKeys
+	})
+}
+
+// NewMaxKeys creates maximum map entries count check, i.e. maxkeys(20)
+func NewMaxKeys() func(field *Field, check *Check) (IsValid, error) {
+	return newMapCountCheck(func(m *mapCheck) IsValid {
+		return m.maxKeys
+	})
+}
+
+// NewHasKeys creates required map keys check, violations are reported at missing key entry, i.e. haskeys(id,name)
+func NewHasKeys() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
+		}
+		if !isMapField(field) {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+		return (&mapCheck{keys: check.Parameters}).hasKeys, nil
+	}
+}
+
+// NewKeysPattern creates map keys regular expression check, violations are reported at each not matching key entry
+func NewKeysPattern() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		if !isMapField(field) {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+		pattern, err := regexp.Compile(check.Parameters[0])
+		if err != nil {
+			return nil, NewInvalidParameterError(check, check.Parameters[0], "expected regular expression", err)
+		}
+		return (&mapCheck{pattern: pattern}).keysPattern, nil
+	}
+}
+
+// NewAllowKeys creates map keys allowlist check, violations are reported at each not allowed key entry, i.e. allowkeys(id,name,tags)
+func NewAllowKeys() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
+		}
+		if !isMapField(field) {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+		ret := &mapCheck{allowed: map[string]bool{}}
+		for _, key := range check.Parameters {
+			ret.allowed[key] = true
+		}
+		return ret.allowKeys, nil
+	}
+}
+
+func newMapCountCheck(factory func(m *mapCheck) IsValid) func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		if !isMapField(field) {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+		count, err := strconv.Atoi(check.Parameters[0])
+		if err != nil || count < 0 {
+			return nil, NewInvalidParameterError(check, check.Parameters[0], "expected non negative integer", err)
+		}
+		return factory(&mapCheck{count: count}), nil
+	}
+}
+
+func isMapField(field *Field) bool {
+	t := field.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Map
+}
+
+// sortedMapKeys returns map keys with their names sorted by name
+func sortedMapKeys(mapValue reflect.Value) ([]reflect.Value, []string) {
+	keys := mapValue.MapKeys()
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = fmt.Sprintf("%v", key.Interface())
+	}
+	sort.Sort(&mapKeys{keys: keys, names: names})
+	return keys, names
+}
+
+type mapKeys struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (m *mapKeys) Len() int {
+	return len(m.keys)
+}
+
+func (m *mapKeys) Less(i, j int) bool {
+	return m.names[i] < m.names[j]
+}
+
+func (m *mapKeys) Swap(i, j int) {
+	m.keys[i], m.keys[j] = m.keys[j], m.keys[i]
+	m.names[i], m.names[j] = m.names[j], m.names[i]
+}
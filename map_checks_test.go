@@ -0,0 +1,108 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testExtension struct {
+	Name string `validate:"required"`
+}
+
+func TestService_Validate_MapChecks(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "minkeys and maxkeys",
+			input: struct {
+				Ext map[string]interface{} `validate:"minkeys(1),maxkeys(2)"`
+			}{Ext: map[string]interface{}{"a": 1}},
+		},
+		{
+			description: "minkeys fails on nil map",
+			input: struct {
+				Ext map[string]interface{} `validate:"minkeys(1)"`
+			}{},
+			expectPaths: []string{"Ext"},
+		},
+		{
+			description: "omitempty skips nil and empty map",
+			input: struct {
+				Ext    map[string]interface{} `validate:"omitempty,minkeys(1)"`
+				Labels map[string]string      `validate:"omitempty,minkeys(1)"`
+				IDs    []string               `validate:"omitempty,containsall(a)"`
+			}{Labels: map[string]string{}, IDs: []string{}},
+		},
+		{
+			description: "maxkeys fails",
+			input: struct {
+				Ext *map[string]int `validate:"maxkeys(1)"`
+			}{Ext: &map[string]int{"a": 1, "b": 2}},
+			expectPaths: []string{"Ext"},
+		},
+		{
+			description: "haskeys reports missing entries",
+			input: struct {
+				Ext map[string]interface{} `validate:"haskeys(id,name,type)"`
+			}{Ext: map[string]interface{}{"name": "x"}},
+			expectPaths: []string{"Ext[id]", "Ext[type]"},
+		},
+		{
+			description: "keyspattern reports offending entries",
+			input: struct {
+				Ext map[string]interface{} `validate:"keyspattern(^x_[a-z]+$)"`
+			}{Ext: map[string]interface{}{"x_ok": 1, "bad": 2, "X_no": 3}},
+			expectPaths: []string{"Ext[X_no]", "Ext[bad]"},
+		},
+		{
+			description: "allowkeys reports not allowed entries",
+			input: struct {
+				Ext map[int]string `validate:"allowkeys(1,2)"`
+			}{Ext: map[int]string{1: "a", 3: "c"}},
+			expectPaths: []string{"Ext[3]"},
+		},
+		{
+			description: "map of structs is walked",
+			input: struct {
+				Ext map[string]*testExtension `validate:"haskeys(main)"`
+			}{Ext: map[string]*testExtension{"main": {Name: "m"}, "b": {}, "a": nil}},
+			expectPaths: []string{"Ext[b].Name"},
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Ext []string `validate:"minkeys(1)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "invalid pattern",
+			input: struct {
+				Ext map[string]string `validate:"keyspattern([)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
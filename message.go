@@ -0,0 +1,116 @@
+package govalidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+type (
+	//MessageData represents data passed to violation message template
+	MessageData struct {
+		Field      string
+		Value      interface{}
+		Check      string
+		Param      string
+		Params     []string
+		OtherField string
+		Location   string
+	}
+
+	//MessageTemplate represents pre-parsed violation message template
+	MessageTemplate struct {
+		text     string
+		template *template.Template
+	}
+
+	messageFuncs struct {
+		funcs template.FuncMap
+		sync.RWMutex
+	}
+)
+
+//Register registers message template function
+func (m *messageFuncs) Register(name string, fn interface{}) {
+	m.RWMutex.Lock()
+	m.funcs[name] = fn
+	m.RWMutex.Unlock()
+}
+
+//FuncMap returns a copy of message template functions
+func (m *messageFuncs) FuncMap() template.FuncMap {
+	m.RWMutex.RLock()
+	ret := make(template.FuncMap, len(m.funcs))
+	for k, v := range m.funcs {
+		ret[k] = v
+	}
+	m.RWMutex.RUnlock()
+	return ret
+}
+
+var _messageFuncs = &messageFuncs{funcs: template.FuncMap{
+	"plural": plural,
+	"join":   strings.Join,
+	"upper":  strings.ToUpper,
+	"lower":  strings.ToLower,
+}}
+
+//RegisterMessageFunc registers message template function, it has to be called before checks are built
+func RegisterMessageFunc(name string, fn interface{}) {
+	_messageFuncs.Register(name, fn)
+}
+
+//IsMessageTemplate returns true if message uses text/template syntax
+func IsMessageTemplate(msg string) bool {
+	return strings.Contains(msg, "{{")
+}
+
+//NewMessageTemplate parses message template
+func NewMessageTemplate(name, text string) (*MessageTemplate, error) {
+	tmpl, err := template.New(name).Funcs(_messageFuncs.FuncMap()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v message template: %w", name, err)
+	}
+	return &MessageTemplate{text: text, template: tmpl}, nil
+}
+
+//Text returns template source
+func (m *MessageTemplate) Text() string {
+	return m.text
+}
+
+//Render renders message with supplied data
+func (m *MessageTemplate) Render(data *MessageData) (string, error) {
+	builder := new(strings.Builder)
+	if err := m.template.Execute(builder, data); err != nil {
+		return "", fmt.Errorf("failed to render %v message: %w", data.Check, err)
+	}
+	return builder.String(), nil
+}
+
+func newMessageData(path *Path, field string, value interface{}, check string, params []string) *MessageData {
+	return &MessageData{
+		Field:      field,
+		Value:      value,
+		Check:      check,
+		Param:      strings.Join(params, ","),
+		Params:     params,
+		OtherField: inferOtherField(check, params),
+		Location:   path.String(),
+	}
+}
+
+func plural(count interface{}, singular, plural string) string {
+	if text, ok := count.(string); ok {
+		if n, err := strconv.ParseFloat(text, 64); err == nil && n == 1 {
+			return singular
+		}
+		return plural
+	}
+	if n, ok := numericValue(count); ok && n == 1 {
+		return singular
+	}
+	return plural
+}
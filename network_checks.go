@@ -0,0 +1,166 @@
+package govalidator
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// nonPublicNetworks lists special purpose ranges that are not globally reachable, beside private, loopback, link local, multicast and unspecified addresses
+var nonPublicNetworks = mustParseCIDRs(
+	"0.0.0.0/8",       //this network
+	"100.64.0.0/10",   //shared address space (carrier grade NAT)
+	"192.0.0.0/24",    //IETF protocol assignments
+	"192.0.2.0/24",    //TEST-NET-1
+	"192.88.99.0/24",  //deprecated 6to4 relay anycast
+	"198.18.0.0/15",   //benchmarking
+	"198.51.100.0/24", //TEST-NET-2
+	"203.0.113.0/24",  //TEST-NET-3
+	"240.0.0.0/4",     //reserved, including limited broadcast
+	"::/96",           //deprecated IPv4-compatible, i.e. ::127.0.0.1, including unspecified and loopback
+	"64:ff9b::/96",    //IPv4/IPv6 translation
+	"64:ff9b:1::/48",  //local use IPv4/IPv6 translation
+	"100::/64",        //discard only
+	"2001::/32",       //Teredo
+	"2001:2::/48",     //benchmarking
+	"2001:10::/28",    //deprecated ORCHID
+	"2001:db8::/32",   //documentation
+	"2002::/16",       //6to4
+	"3fff::/20",       //documentation
+	"5f00::/16",       //segment routing SIDs
+)
+
+// NewIPIn creates check that IP address belongs to one of listed networks, bare IP parameter matches single address, i.e. ipin(10.0.0.0/8,192.168.1.10)
+func NewIPIn() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
+		}
+		var networks []*net.IPNet
+		for _, param := range check.Parameters {
+			network, err := parseNetwork(param)
+			if err != nil {
+				return nil, NewInvalidParameterError(check, param, "expected CIDR or IP address", err)
+			}
+			networks = append(networks, network)
+		}
+		return newFormatCheck("ipin", func(actual string) bool {
+			ip := net.ParseIP(actual)
+			return ip != nil && containsIP(networks, ip)
+		})(field, check)
+	}
+}
+
+// NewPublicIP creates check that IP address is globally reachable, private, loopback, link local, multicast and special purpose addresses fail
+func NewPublicIP() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("publicip", func(actual string) bool {
+		ip := net.ParseIP(actual)
+		return ip != nil && isPublicIP(ip)
+	})
+}
+
+// NewPrivateIP creates RFC 1918 and RFC 4193 private IP address check
+func NewPrivateIP() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("privateip", func(actual string) bool {
+		ip := net.ParseIP(actual)
+		return ip != nil && ip.IsPrivate()
+	})
+}
+
+// NewLoopback creates loopback IP address check
+func NewLoopback() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("loopback", func(actual string) bool {
+		ip := net.ParseIP(actual)
+		return ip != nil && ip.IsLoopback()
+	})
+}
+
+// NewHostPort creates host:port check, host has to be a host name or IP address (IPv6 in brackets) and port in 1-65535 range
+func NewHostPort() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("hostport", func(actual string) bool {
+		host, port, err := net.SplitHostPort(actual)
+		if err != nil || host == "" || !isValidPort(port) {
+			return false
+		}
+		return net.ParseIP(host) != nil || hostnameRFC1123Regex.MatchString(host)
+	})
+}
+
+// NewTCPAddr creates TCP address check that does not need name resolution: optional IP address host and port in 0-65535 range, i.e. :8080 or 127.0.0.1:0
+func NewTCPAddr() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("tcpaddr", func(actual string) bool {
+		host, port, err := net.SplitHostPort(actual)
+		if err != nil {
+			return false
+		}
+		if host != "" && net.ParseIP(host) == nil {
+			return false
+		}
+		value, err := strconv.Atoi(port)
+		return err == nil && value >= 0 && value <= 65535
+	})
+}
+
+// NewIPVersion creates IP address family check, i.e. ipversion(4) or ipversion(6)
+func NewIPVersion() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		version := strings.TrimSpace(check.Parameters[0])
+		if version != "4" && version != "6" {
+			return nil, NewInvalidParameterError(check, check.Parameters[0], "expected 4 or 6", nil)
+		}
+		return newFormatCheck("ipversion", func(actual string) bool {
+			ip := net.ParseIP(actual)
+			if ip == nil {
+				return false
+			}
+			return (ip.To4() != nil) == (version == "4")
+		})(field, check)
+	}
+}
+
+func isPublicIP(ip net.IP) bool {
+	if ip.IsPrivate() || ip.IsLoopback() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || !ip.IsGlobalUnicast() {
+		return false
+	}
+	return !containsIP(nonPublicNetworks, ip)
+}
+
+func containsIP(networks []*net.IPNet, ip net.IP) bool {
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseNetwork parses CIDR, bare IP address is parsed as single address network
+func parseNetwork(literal string) (*net.IPNet, error) {
+	literal = strings.TrimSpace(literal)
+	if !strings.Contains(literal, "/") {
+		if ip := net.ParseIP(literal); ip != nil {
+			if ip4 := ip.To4(); ip4 != nil {
+				return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+			}
+			return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+		}
+	}
+	_, network, err := net.ParseCIDR(literal)
+	return network, err
+}
+
+func mustParseCIDRs(literals ...string) []*net.IPNet {
+	var ret []*net.IPNet
+	for _, literal := range literals {
+		_, network, err := net.ParseCIDR(literal)
+		if err != nil {
+			panic(err)
+		}
+		ret = append(ret, network)
+	}
+	return ret
+}
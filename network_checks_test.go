@@ -0,0 +1,99 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_NetworkChecks(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "ipin",
+			input: struct {
+				Allowed []string `validate:"ipin(10.0.0.0/8,192.168.1.10,fd00::/8)"`
+			}{Allowed: []string{"10.1.2.3", "192.168.1.10", "fd00::1", "::ffff:10.0.0.1", "192.168.1.11", "11.0.0.1", "host"}},
+			expectPaths: []string{"Allowed[4]", "Allowed[5]", "Allowed[6]"},
+		},
+		{
+			description: "invalid ipin network",
+			input: struct {
+				Addr string `validate:"ipin(10.0.0.0/33)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "publicip",
+			input: struct {
+				Targets []string `validate:"publicip"`
+			}{Targets: []string{"8.8.8.8", "2606:4700:4700::1111", "10.0.0.1", "127.0.0.1", "169.254.169.254", "100.64.0.1", "::ffff:192.168.0.1", "::1", "0.0.0.0", "224.0.0.1", "2001:db8::1"}},
+			expectPaths: []string{"Targets[2]", "Targets[3]", "Targets[4]", "Targets[5]", "Targets[6]", "Targets[7]", "Targets[8]", "Targets[9]", "Targets[10]"},
+		},
+		{
+			description: "publicip embedded IPv4 and special purpose IPv6",
+			input: struct {
+				Targets []string `validate:"publicip"`
+			}{Targets: []string{"2001:4860:4860::8888", "::127.0.0.1", "::10.0.0.1", "::8.8.8.8", "::ffff:127.0.0.1", "::ffff:10.0.0.1", "2001:0:4136:e378:8000:63bf:3fff:fdd2", "2001:2::1", "3fff::1", "192.88.99.1"}},
+			expectPaths: []string{"Targets[1]", "Targets[2]", "Targets[3]", "Targets[4]", "Targets[5]", "Targets[6]", "Targets[7]", "Targets[8]", "Targets[9]"},
+		},
+		{
+			description: "privateip and loopback",
+			input: struct {
+				Private  []string `validate:"privateip"`
+				Loopback []string `validate:"loopback"`
+			}{Private: []string{"172.16.0.1", "fd12::1", "8.8.8.8"}, Loopback: []string{"127.0.0.2", "::1", "10.0.0.1"}},
+			expectPaths: []string{"Private[2]", "Loopback[2]"},
+		},
+		{
+			description: "hostport",
+			input: struct {
+				Addrs []string `validate:"hostport"`
+			}{Addrs: []string{"api.example.com:443", "[::1]:8080", "10.0.0.1:80", ":80", "example.com", "example.com:0", "bad_host!:80"}},
+			expectPaths: []string{"Addrs[3]", "Addrs[4]", "Addrs[5]", "Addrs[6]"},
+		},
+		{
+			description: "tcpaddr",
+			input: struct {
+				Addrs []string `validate:"tcpaddr"`
+			}{Addrs: []string{":8080", "127.0.0.1:0", "[::]:443", "localhost:80", "10.0.0.1:65536", "10.0.0.1"}},
+			expectPaths: []string{"Addrs[3]", "Addrs[4]", "Addrs[5]"},
+		},
+		{
+			description: "ipversion",
+			input: struct {
+				V4 []string `validate:"ipversion(4)"`
+				V6 string   `validate:"ipversion(6)"`
+			}{V4: []string{"10.0.0.1", "::1"}, V6: "10.0.0.1"},
+			expectPaths: []string{"V6", "V4[1]"},
+		},
+		{
+			description: "invalid ipversion",
+			input: struct {
+				Addr string `validate:"ipversion(5)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
@@ -3,422 +3,271 @@ package govalidator
 import (
 	"context"
 	"fmt"
-	"github.com/viant/xunsafe"
+	"math"
+	"math/big"
 	"reflect"
-	"strconv"
-	"unicode/utf8"
+	"strings"
 )
 
-type Numeric struct{ param int }
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+	bigRatType   = reflect.TypeOf(big.Rat{})
+)
 
-func (n *Numeric) intGt(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+type (
+	//Numeric represents numeric comparison check, for strings and slices length is compared
+	Numeric struct {
+		param     *numericParam
+		predicate func(cmp int) bool
 	}
-	return intValue(value) > n.param, nil
-}
 
-func (n *Numeric) floatGt(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+	//numericParam represents exact numeric check parameter
+	numericParam struct {
+		literal string
+		rat     *big.Rat
+		float64 float64
+		float32 float32
+		int     int64
+		isInt   bool
 	}
-	return floatValue(value) > float64(n.param), nil
-}
+)
 
-func (n *Numeric) stringGt(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
+func (n *Numeric) number(ctx context.Context, value interface{}) (bool, error) {
+	cmp, ok := n.param.compare(value)
+	if !ok {
 		return false, nil
 	}
-	return int(utf8.RuneCountInString(stringValue(value))) > n.param, nil
-}
-func (n *Numeric) timeGt(ctx context.Context, value interface{}) (bool, error) {
-	return int(utf8.RuneCountInString(stringValue(value))) > n.param, nil
+	return n.predicate(cmp), nil
 }
 
-func (n *Numeric) sliceGt(ctx context.Context, value interface{}) (bool, error) {
-	return sliceLen(value) > n.param, nil
-}
-
-func (n *Numeric) intLt(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
+func (n *Numeric) length(ctx context.Context, value interface{}) (bool, error) {
+	length, ok := valueLength(value)
+	if !ok {
 		return false, nil
 	}
-	return intValue(value) < n.param, nil
+	return n.predicate(compareInt64(int64(length), n.param.int)), nil
 }
 
-func (n *Numeric) floatLt(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+// newNumericParam parses decimal, scientific or fraction literal
+func newNumericParam(literal string) (*numericParam, error) {
+	literal = strings.TrimSpace(literal)
+	rat, ok := new(big.Rat).SetString(literal)
+	if !ok {
+		return nil, fmt.Errorf("invalid number: %q", literal)
 	}
-	return floatValue(value) < float64(n.param), nil
+	return newRatParam(literal, rat), nil
 }
 
-func (n *Numeric) stringLt(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+// newNumericValueParam returns exact param of number or big number value, i.e. other field value in gtfield; NaN, infinity and strings are not numeric
+func newNumericValueParam(value interface{}) (*numericParam, bool) {
+	switch value.(type) {
+	case *big.Int, big.Int, *big.Float, big.Float, *big.Rat, big.Rat:
+	default:
+		rv, isNil := derefReflectValue(value)
+		if isNil || !isNumericKind(rv.Kind()) {
+			return nil, false
+		}
 	}
-	return int(utf8.RuneCountInString(stringValue(value))) < n.param, nil
-}
-func (n *Numeric) timeLt(ctx context.Context, value interface{}) (bool, error) {
-	return int(utf8.RuneCountInString(stringValue(value))) < n.param, nil
-}
-
-func (n *Numeric) sliceLt(ctx context.Context, value interface{}) (bool, error) {
-	return sliceLen(value) < n.param, nil
-}
-
-func (n *Numeric) intGte(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+	rat, ok := decimalValue(value)
+	if !ok {
+		return nil, false
 	}
-	return intValue(value) >= n.param, nil
+	return newRatParam(rat.RatString(), rat), true
 }
 
-func (n *Numeric) floatGte(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+func newRatParam(literal string, rat *big.Rat) *numericParam {
+	ret := &numericParam{literal: literal, rat: rat}
+	ret.float64, _ = rat.Float64()
+	ret.float32, _ = rat.Float32()
+	if rat.IsInt() && rat.Num().IsInt64() {
+		ret.int = rat.Num().Int64()
+		ret.isInt = true
 	}
-	return floatValue(value) >= float64(n.param), nil
+	return ret
 }
 
-func (n *Numeric) stringGte(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+// compare compares value with param, it returns false for nil, NaN or non numeric value.
+// Integers and big numbers are compared exactly, floats are compared with param rounded to the value precision.
+func (p *numericParam) compare(value interface{}) (int, bool) {
+	switch actual := value.(type) {
+	case *big.Int:
+		if actual == nil {
+			return 0, false
+		}
+		return new(big.Rat).SetInt(actual).Cmp(p.rat), true
+	case big.Int:
+		return new(big.Rat).SetInt(&actual).Cmp(p.rat), true
+	case *big.Float:
+		if actual == nil {
+			return 0, false
+		}
+		return p.compareBigFloat(actual), true
+	case big.Float:
+		return p.compareBigFloat(&actual), true
+	case *big.Rat:
+		if actual == nil {
+			return 0, false
+		}
+		return actual.Cmp(p.rat), true
+	case big.Rat:
+		return actual.Cmp(p.rat), true
 	}
-	return int(utf8.RuneCountInString(stringValue(value))) >= n.param, nil
-}
-
-func (n *Numeric) timeGte(ctx context.Context, value interface{}) (bool, error) {
-	return int(utf8.RuneCountInString(stringValue(value))) >= n.param, nil
-}
-
-func (n *Numeric) sliceGte(ctx context.Context, value interface{}) (bool, error) {
-	return sliceLen(value) >= n.param, nil
-}
-
-func (n *Numeric) intLte(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+	rv, isNil := derefReflectValue(value)
+	if isNil {
+		return 0, false
 	}
-	return intValue(value) <= n.param, nil
-}
-
-func (n *Numeric) floatLte(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual := rv.Int()
+		if p.isInt {
+			return compareInt64(actual, p.int), true
+		}
+		return new(big.Rat).SetInt64(actual).Cmp(p.rat), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual := rv.Uint()
+		if p.isInt {
+			if p.int < 0 {
+				return 1, true
+			}
+			return compareUint64(actual, uint64(p.int)), true
+		}
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(actual)).Cmp(p.rat), true
+	case reflect.Float32:
+		actual := float32(rv.Float())
+		if math.IsNaN(float64(actual)) {
+			return 0, false
+		}
+		return compareFloat64(float64(actual), float64(p.float32)), true
+	case reflect.Float64:
+		actual := rv.Float()
+		if math.IsNaN(actual) {
+			return 0, false
+		}
+		return compareFloat64(actual, p.float64), true
 	}
-	return floatValue(value) <= float64(n.param), nil
+	return 0, false
 }
 
-func (n *Numeric) stringLte(ctx context.Context, value interface{}) (bool, error) {
-	if isBaseTypeNil(value) {
-		return false, nil
+func (p *numericParam) compareBigFloat(actual *big.Float) int {
+	if actual.IsInf() {
+		return actual.Sign()
 	}
-	return int(utf8.RuneCountInString(stringValue(value))) <= n.param, nil
-}
-
-func (n *Numeric) timeLte(ctx context.Context, value interface{}) (bool, error) {
-	return int(utf8.RuneCountInString(stringValue(value))) <= n.param, nil
+	prec := actual.Prec()
+	if prec == 0 {
+		prec = 64
+	}
+	return actual.Cmp(new(big.Float).SetPrec(prec).SetRat(p.rat))
 }
 
-func (n *Numeric) sliceLte(ctx context.Context, value interface{}) (bool, error) {
-	return sliceLen(value) <= n.param, nil
+func compareInt64(left, right int64) int {
+	switch {
+	case left > right:
+		return 1
+	case left < right:
+		return -1
+	}
+	return 0
 }
 
-func sliceLen(value interface{}) int {
-	header := (*reflect.SliceHeader)(xunsafe.AsPointer(value))
-	return header.Len
-}
-func isBaseTypeNil(value interface{}) bool {
-	if value == nil {
-		return true
+func compareUint64(left, right uint64) int {
+	switch {
+	case left > right:
+		return 1
+	case left < right:
+		return -1
 	}
-	switch actual := value.(type) {
-	case *string:
-		return (*string)(xunsafe.AsPointer(actual)) == nil
-	case *int, *int64, *uint, *uint64:
-		return (*int)(xunsafe.AsPointer(actual)) == nil
-	case *uint32, *int32:
-		return (*int32)(xunsafe.AsPointer(actual)) == nil
-	case *int16, *uint16:
-		return (*int16)(xunsafe.AsPointer(actual)) == nil
-	case *int8, *uint8:
-		return (*int8)(xunsafe.AsPointer(actual)) == nil
-	}
-	return false
+	return 0
 }
-func intValue(value interface{}) int {
-	switch actual := value.(type) {
-	case int:
-		return actual
-	case int64:
-		return int(actual)
-	case uint:
-		return int(actual)
-	case uint64:
-		return int(actual)
-	case int32:
-		return int(actual)
-	case uint32:
-		return int(actual)
-	case int16:
-		return int(actual)
-	case uint16:
-		return int(actual)
-	case int8:
-		return int(actual)
-	case uint8:
-		return int(actual)
-	case *int, *int64, *uint, *uint64:
-		return *(*int)(xunsafe.AsPointer(actual))
-	case *uint32, *int32:
-		return int(*(*int32)(xunsafe.AsPointer(actual)))
-	case *int16, *uint16:
-		return int(*(*int16)(xunsafe.AsPointer(actual)))
-	case *int8, *uint8:
-		return int(*(*int8)(xunsafe.AsPointer(actual)))
+
+func compareFloat64(left, right float64) int {
+	switch {
+	case left > right:
+		return 1
+	case left < right:
+		return -1
 	}
 	return 0
 }
 
-func stringValue(value interface{}) string {
-	switch actual := value.(type) {
-	case string:
-		return actual
-	case *string:
-		if actual == nil {
-			return ""
-		}
-		return *actual
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
 	}
-	return ""
+	return false
 }
 
-func floatValue(value interface{}) float64 {
-	switch actual := value.(type) {
-	case float64:
-		return float64(actual)
-	case float32:
-		return float64(actual)
-
-	case *float64:
-		if actual == nil {
-			return 0
-		}
-		return float64(*actual)
-	case *float32:
-		if actual == nil {
-			return 0
-		}
-		return float64(*actual)
+func isBigNumber(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
 	}
-	return 0
+	return t == bigIntType || t == bigFloatType || t == bigRatType
 }
 
 // NewGt creates greater than validation check
 func NewGt() func(field *Field, check *Check) (IsValid, error) {
-	return func(field *Field, check *Check) (IsValid, error) {
-		param, err := strconv.Atoi(check.Parameters[0])
-		if err != nil {
-			return nil, fmt.Errorf("invalid parameter: %v", err)
-		}
-		ret := &Numeric{param: param}
-
-		switch field.Kind() {
-		case reflect.String:
-			return ret.stringGt, nil
-		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-			return ret.intGt, nil
-		case reflect.Float64, reflect.Float32:
-			return ret.floatGt, nil
-		case reflect.Slice:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringGt, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intGt, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatGt, nil
-			case reflect.Slice:
-				return ret.sliceGt, nil
-			}
-		case reflect.Ptr:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringGt, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intGt, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatGt, nil
-			case reflect.Slice:
-				switch field.Elem().Elem().Kind() {
-				case reflect.String:
-					return ret.stringGt, nil
-				case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-					return ret.intGt, nil
-				case reflect.Float64, reflect.Float32:
-					return ret.floatGt, nil
-				case reflect.Slice:
-					return ret.sliceGt, nil
-				}
-			}
-		}
-		return nil, fmt.Errorf("unsupported ge type: %s", field.Type.String())
-	}
+	return newNumericCheck(func(cmp int) bool {
+		return cmp > 0
+	})
 }
 
 // NewLt creates less than validation check
 func NewLt() func(field *Field, check *Check) (IsValid, error) {
-	return func(field *Field, check *Check) (IsValid, error) {
-		param, err := strconv.Atoi(check.Parameters[0])
-		if err != nil {
-			return nil, fmt.Errorf("invalid parameter: %v", err)
-		}
-		ret := &Numeric{param: param}
-
-		switch field.Kind() {
-		case reflect.String:
-			return ret.stringLt, nil
-		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-			return ret.intLt, nil
-		case reflect.Float64, reflect.Float32:
-			return ret.floatLt, nil
-		case reflect.Slice:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringLt, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intLt, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatLt, nil
-			case reflect.Slice:
-				return ret.sliceLt, nil
-			}
-		case reflect.Ptr:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringLt, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intLt, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatLt, nil
-			case reflect.Slice:
-				switch field.Elem().Elem().Kind() {
-				case reflect.String:
-					return ret.stringLt, nil
-				case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-					return ret.intLt, nil
-				case reflect.Float64, reflect.Float32:
-					return ret.floatLt, nil
-				case reflect.Slice:
-					return ret.sliceLt, nil
-				}
-			}
-		}
-		return nil, fmt.Errorf("unsupported ge type: %s", field.Type.String())
-	}
+	return newNumericCheck(func(cmp int) bool {
+		return cmp < 0
+	})
 }
 
 // NewGte creates greater or equal than validation check
 func NewGte() func(field *Field, check *Check) (IsValid, error) {
-	return func(field *Field, check *Check) (IsValid, error) {
-		param, err := strconv.Atoi(check.Parameters[0])
-		if err != nil {
-			return nil, fmt.Errorf("invalid parameter: %v", err)
-		}
-		ret := &Numeric{param: param}
-
-		switch field.Kind() {
-		case reflect.String:
-			return ret.stringGte, nil
-		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-			return ret.intGte, nil
-		case reflect.Float64, reflect.Float32:
-			return ret.floatGte, nil
-		case reflect.Slice:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringGte, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intGte, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatGte, nil
-			case reflect.Slice:
-				return ret.sliceGte, nil
-			}
-		case reflect.Ptr:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringGte, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intGte, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatGte, nil
-			case reflect.Slice:
-				switch field.Elem().Elem().Kind() {
-				case reflect.String:
-					return ret.stringGte, nil
-				case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-					return ret.intGte, nil
-				case reflect.Float64, reflect.Float32:
-					return ret.floatGte, nil
-				case reflect.Slice:
-					return ret.sliceGte, nil
-				}
-			}
-		}
-		return nil, fmt.Errorf("unsupported ge type: %s", field.Type.String())
-	}
+	return newNumericCheck(func(cmp int) bool {
+		return cmp >= 0
+	})
 }
 
 // NewLte creates less or equal than validation check
 func NewLte() func(field *Field, check *Check) (IsValid, error) {
+	return newNumericCheck(func(cmp int) bool {
+		return cmp <= 0
+	})
+}
+
+func newNumericCheck(predicate func(cmp int) bool) func(field *Field, check *Check) (IsValid, error) {
 	return func(field *Field, check *Check) (IsValid, error) {
-		param, err := strconv.Atoi(check.Parameters[0])
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		params, durationString, err := newNumericParams(field, check)
 		if err != nil {
-			return nil, fmt.Errorf("invalid parameter: %v", err)
+			return nil, err
 		}
-		ret := &Numeric{param: param}
-
-		switch field.Kind() {
-		case reflect.String:
-			return ret.stringLte, nil
-		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-			return ret.intLte, nil
-		case reflect.Float64, reflect.Float32:
-			return ret.floatLte, nil
-		case reflect.Slice:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringLte, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intLte, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatLte, nil
-			case reflect.Slice:
-				return ret.sliceLte, nil
-			}
-		case reflect.Ptr:
-			switch field.Elem().Kind() {
-			case reflect.String:
-				return ret.stringLte, nil
-			case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-				return ret.intLte, nil
-			case reflect.Float64, reflect.Float32:
-				return ret.floatLte, nil
-			case reflect.Slice:
-				switch field.Elem().Elem().Kind() {
-				case reflect.String:
-					return ret.stringLte, nil
-				case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Int32, reflect.Uint32, reflect.Int16, reflect.Uint16, reflect.Int8, reflect.Uint8:
-					return ret.intLte, nil
-				case reflect.Float64, reflect.Float32:
-					return ret.floatLte, nil
-				case reflect.Slice:
-					return ret.sliceLte, nil
-				}
+		param := params[0]
+		ret := &Numeric{param: param, predicate: predicate}
+		if isBigNumber(field.Type) {
+			return ret.number, nil
+		}
+		if field.isNumberString() {
+			return numberStringCheck(ret.number), nil
+		}
+		if durationString {
+			return durationStringCheck(ret.number), nil
+		}
+		kind, elemKind := typeKinds(field)
+		if kind == reflect.Slice {
+			kind = elemKind
+		}
+		switch {
+		case kind == reflect.String, kind == reflect.Slice:
+			if !param.isInt {
+				return nil, NewInvalidParameterError(check, check.Parameters[0], "length expects integer", nil)
 			}
+			return ret.length, nil
+		case isNumericKind(kind):
+			return ret.number, nil
 		}
-		return nil, fmt.Errorf("unsupported ge type: %s", field.Type.String())
+		return nil, NewUnsupportedTypeError(field, check)
 	}
 }
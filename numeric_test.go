@@ -0,0 +1,415 @@
+package govalidator
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_NumericExact(t *testing.T) {
+	bigValue, _ := new(big.Int).SetString("100000000000000000000", 10)
+	var testCases = []struct {
+		description  string
+		input        interface{}
+		expectFailed bool
+		expectErr    bool
+	}{
+		{
+			description: "decimal gt passes",
+			input: struct {
+				Value float64 `validate:"gt(0.5)"`
+			}{Value: 0.75},
+		},
+		{
+			description: "decimal gt fails",
+			input: struct {
+				Value float64 `validate:"gt(0.5)"`
+			}{Value: 0.5},
+			expectFailed: true,
+		},
+		{
+			description: "float param equal to float value",
+			input: struct {
+				Value float32 `validate:"le(0.1),ge(0.1)"`
+			}{Value: 0.1},
+		},
+		{
+			description: "scientific literal",
+			input: struct {
+				Value int `validate:"between(-1e3,2.5e3)"`
+			}{Value: 2500},
+		},
+		{
+			description: "decimal param on int",
+			input: struct {
+				Value int `validate:"lt(2.5)"`
+			}{Value: 3},
+			expectFailed: true,
+		},
+		{
+			description: "uint64 above max int64",
+			input: struct {
+				Value uint64 `validate:"gt(9223372036854775807)"`
+			}{Value: math.MaxUint64},
+		},
+		{
+			description: "uint64 max fails",
+			input: struct {
+				Value uint64 `validate:"max(18446744073709551614)"`
+			}{Value: math.MaxUint64},
+			expectFailed: true,
+		},
+		{
+			description: "int64 near max is exact",
+			input: struct {
+				Value int64 `validate:"lt(9223372036854775807)"`
+			}{Value: math.MaxInt64 - 1},
+		},
+		{
+			description: "uint against negative param",
+			input: struct {
+				Value uint8 `validate:"gt(-1)"`
+			}{Value: 0},
+		},
+		{
+			description: "big int pointer",
+			input: struct {
+				Value *big.Int `validate:"gt(99999999999999999999)"`
+			}{Value: bigValue},
+		},
+		{
+			description: "big int nil pointer omitempty",
+			input: struct {
+				Value *big.Int `validate:"omitempty,gt(1)"`
+			}{},
+		},
+		{
+			description: "big float",
+			input: struct {
+				Value *big.Float `validate:"max(0.3)"`
+			}{Value: big.NewFloat(0.3)},
+		},
+		{
+			description: "big rat",
+			input: struct {
+				Value big.Rat `validate:"lt(1/3)"`
+			}{Value: *big.NewRat(1, 3)},
+			expectFailed: true,
+		},
+		{
+			description: "NaN fails",
+			input: struct {
+				Value float64 `validate:"min(0)"`
+			}{Value: math.NaN()},
+			expectFailed: true,
+		},
+		{
+			description: "string length expects integer",
+			input: struct {
+				Value string `validate:"gt(0.5)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "between min greater than max",
+			input: struct {
+				Value int `validate:"between(10,1)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "between duration min greater than max",
+			input: struct {
+				Timeout time.Duration `validate:"between(5m,100ms)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "between equal bounds",
+			input: struct {
+				Value int `validate:"between(3,3)"`
+			}{Value: 3},
+		},
+		{
+			description: "invalid number",
+			input: struct {
+				Value int `validate:"min(abc)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		assert.EqualValues(t, testCase.expectFailed, validation.Failed, testCase.description)
+	}
+}
+
+func TestService_Validate_Decimal(t *testing.T) {
+	price := "12.345"
+	var testCases = []struct {
+		description  string
+		input        interface{}
+		expectFailed bool
+		expectErr    bool
+	}{
+		{
+			description: "float multiple of quarter",
+			input: struct {
+				Value float64 `validate:"multipleof(0.25)"`
+			}{Value: 1.75},
+		},
+		{
+			description: "float not multiple of quarter",
+			input: struct {
+				Value float64 `validate:"multipleof(0.25)"`
+			}{Value: 1.8},
+			expectFailed: true,
+		},
+		{
+			description: "float decimal multiple",
+			input: struct {
+				Value float32 `validate:"multipleof(0.01)"`
+			}{Value: 19.99},
+		},
+		{
+			description: "int step",
+			input: struct {
+				Value int `validate:"step(5)"`
+			}{Value: 15},
+		},
+		{
+			description: "int step fails",
+			input: struct {
+				Value int `validate:"step(5)"`
+			}{Value: 17},
+			expectFailed: true,
+		},
+		{
+			description: "step with base",
+			input: struct {
+				Value float64 `validate:"step(0.5,0.25)"`
+			}{Value: 1.75},
+		},
+		{
+			description: "numeric string multiple",
+			input: struct {
+				Value string `validate:"multipleof(0.05)"`
+			}{Value: "10.15"},
+		},
+		{
+			description: "non numeric string fails",
+			input: struct {
+				Value string `validate:"multipleof(1)"`
+			}{Value: "abc"},
+			expectFailed: true,
+		},
+		{
+			description: "big int multiple",
+			input: struct {
+				Value *big.Int `validate:"multipleof(1000)"`
+			}{Value: big.NewInt(25000)},
+		},
+		{
+			description: "decimal fits",
+			input: struct {
+				Value float64 `validate:"decimal(5,2)"`
+			}{Value: 999.99},
+		},
+		{
+			description: "decimal scale exceeded",
+			input: struct {
+				Value *string `validate:"decimal(5,2)"`
+			}{Value: &price},
+			expectFailed: true,
+		},
+		{
+			description: "decimal integer digits exceeded",
+			input: struct {
+				Value int `validate:"decimal(5,2)"`
+			}{Value: 1000},
+			expectFailed: true,
+		},
+		{
+			description: "decimal trailing zeros ignored",
+			input: struct {
+				Value string `validate:"decimal(4,2)"`
+			}{Value: "-12.3000"},
+		},
+		{
+			description: "decimal repeating fraction fails",
+			input: struct {
+				Value *big.Rat `validate:"decimal(10,4)"`
+			}{Value: big.NewRat(1, 3)},
+			expectFailed: true,
+		},
+		{
+			description: "decimal slice element",
+			input: struct {
+				Values []string `validate:"decimal(3,1)"`
+			}{Values: []string{"1.5", "10.25"}},
+			expectFailed: true,
+		},
+		{
+			description: "decimal large exponent fails",
+			input: struct {
+				Value string `validate:"decimal(10,2)"`
+			}{Value: "1e1000000"},
+			expectFailed: true,
+		},
+		{
+			description: "multiple of large exponent fails",
+			input: struct {
+				Value string `validate:"multipleof(0.01)"`
+			}{Value: "1e-1000000"},
+			expectFailed: true,
+		},
+		{
+			description: "decimal bounded exponent",
+			input: struct {
+				Value string `validate:"decimal(20,2)"`
+			}{Value: "1.25e15"},
+		},
+		{
+			description: "non positive step",
+			input: struct {
+				Value int `validate:"multipleof(0)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "scale greater than precision",
+			input: struct {
+				Value float64 `validate:"decimal(2,3)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Value bool `validate:"step(1)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		assert.EqualValues(t, testCase.expectFailed, validation.Failed, testCase.description)
+	}
+}
+
+func TestService_Validate_Duration(t *testing.T) {
+	timeout := 10 * time.Minute
+	var testCases = []struct {
+		description   string
+		input         interface{}
+		expectFailed  bool
+		expectErr     bool
+		expectMessage string
+	}{
+		{
+			description: "duration min",
+			input: struct {
+				Timeout time.Duration `validate:"min(1s)"`
+			}{Timeout: 2 * time.Second},
+		},
+		{
+			description: "duration between",
+			input: struct {
+				Timeout *time.Duration `validate:"between(100ms,5m)"`
+			}{Timeout: &timeout},
+			expectFailed:  true,
+			expectMessage: "check 'between' failed on field Timeout",
+		},
+		{
+			description: "plain number is nanoseconds",
+			input: struct {
+				Timeout time.Duration `validate:"max(60)"`
+			}{Timeout: time.Second},
+			expectFailed: true,
+		},
+		{
+			description: "duration message",
+			input: struct {
+				Timeout time.Duration `validate:"max(60000000000),message=$field $value exceeds $param"`
+			}{Timeout: 90 * time.Second},
+			expectFailed:  true,
+			expectMessage: "Timeout 1m30s exceeds 1m0s",
+		},
+		{
+			description: "duration template message",
+			input: struct {
+				Timeout time.Duration `validate:"lt(1m),message={{.Field}} {{.Value}} is not below {{.Param}}"`
+			}{Timeout: time.Minute},
+			expectFailed:  true,
+			expectMessage: "Timeout 1m0s is not below 1m",
+		},
+		{
+			description: "duration string",
+			input: struct {
+				Interval string `validate:"ge(1s)"`
+			}{Interval: "1m30s"},
+		},
+		{
+			description: "duration string fails",
+			input: struct {
+				Intervals []string `validate:"between(1s,1h)"`
+			}{Intervals: []string{"1m", "2h"}},
+			expectFailed: true,
+		},
+		{
+			description: "invalid duration string",
+			input: struct {
+				Interval string `validate:"gt(1s)"`
+			}{Interval: "soon"},
+			expectFailed: true,
+		},
+		{
+			description: "string length is unchanged",
+			input: struct {
+				Name string `validate:"min(2)"`
+			}{Name: "ab"},
+		},
+		{
+			description: "invalid duration param",
+			input: struct {
+				Timeout time.Duration `validate:"min(1y)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		assert.EqualValues(t, testCase.expectFailed, validation.Failed, testCase.description)
+		if testCase.expectMessage != "" && assert.Len(t, validation.Violations, 1, testCase.description) {
+			assert.EqualValues(t, testCase.expectMessage, validation.Violations[0].Message, testCase.description)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package govalidator
 
-import "github.com/viant/structology"
+import (
+	"io/fs"
+
+	"github.com/viant/structology"
+)
 
 type CanUseMarkerProvider func(v interface{}) bool
 
@@ -12,6 +16,9 @@ type (
 		Shallow              bool
 		Path                 *Path
 		CanUseMarkerProvider CanUseMarkerProvider
+		Sensitive            bool
+		DomainResolver       DomainResolver
+		FS                   fs.FS
 	}
 
 	Option func(c *Options)
@@ -53,6 +60,13 @@ func WithCanUseMarkerProvider(provider CanUseMarkerProvider) Option {
 	}
 }
 
+// WithSensitive creates with sensitive option, all violation values are redacted
+func WithSensitive(flag bool) Option {
+	return func(c *Options) {
+		c.Sensitive = flag
+	}
+}
+
 // newOptions creates an options
 func newOptions() *Options {
 	return &Options{}
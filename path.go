@@ -45,6 +45,16 @@ func (p *Path) Element(index int) *Path {
 	return &Path{Index: index, Kind: PathKindIndex, Path: p}
 }
 
+// join appends nodes of relative path, rooted with NewPath, to the path
+func (p *Path) join(relative *Path) *Path {
+	if relative == nil || relative.Kind == PathKindRoot {
+		return p
+	}
+	ret := *relative
+	ret.Path = p.join(relative.Path)
+	return &ret
+}
+
 // String stringifies a path
 func (p *Path) String() string {
 	builder := new(strings.Builder)
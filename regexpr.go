@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 type RegExprCheck struct {
@@ -74,7 +75,7 @@ func NewRegExprCheck(expr *regexp.Regexp) func(field *Field, check *Check) (IsVa
 				return ret.IsValidStringPtr, nil
 			}
 		}
-		return nil, fmt.Errorf("unsupported regexpr based %v check type: %s", field.Tag, field.Type.String())
+		return nil, NewUnsupportedTypeError(field, check)
 	}
 }
 
@@ -134,6 +135,60 @@ func NewRegExprCheckWithMinLength(expr *regexp.Regexp, minLen int) func(field *F
 				return ret.IsValidStringPtr, nil
 			}
 		}
-		return nil, fmt.Errorf("unsupported regexpr based %v check type: %s", field.Tag, field.Type.String())
+		return nil, NewUnsupportedTypeError(field, check)
 	}
 }
+
+const patternRefPrefix = "@"
+
+var (
+	//_patterns holds named patterns shared with RegisterPattern
+	_patterns sync.Map
+	//_compiled caches patterns compiled from regexp check parameters by source
+	_compiled sync.Map
+
+	patternNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.\-]*$`)
+)
+
+//RegisterPattern registers named pattern used with regexp(@name) check
+func RegisterPattern(name string, expr *regexp.Regexp) {
+	_patterns.Store(name, expr)
+}
+
+//NewRegExp creates ad hoc regular expression check, i.e. regexp(^[A-Z]{3}$), or named pattern check, i.e. regexp(@sku)
+func NewRegExp() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
+		}
+		if len(check.Parameters) > 1 {
+			return nil, NewInvalidParameterError(check, check.Parameters[1], "expected single pattern, quote pattern with commas, i.e. regexp('^[^,]+$')", nil)
+		}
+		source := check.Parameters[0]
+		expr, err := lookupPattern(source)
+		if err != nil {
+			return nil, NewInvalidParameterError(check, source, "expected regular expression or registered @pattern", err)
+		}
+		return newFormatCheck("regexp", expr.MatchString)(field, check)
+	}
+}
+
+// lookupPattern returns registered named pattern for @name source, otherwise source compiled once
+func lookupPattern(source string) (*regexp.Regexp, error) {
+	if name := strings.TrimPrefix(source, patternRefPrefix); name != source && patternNameRegex.MatchString(name) {
+		expr, ok := _patterns.Load(name)
+		if !ok {
+			return nil, fmt.Errorf("pattern %v was not registered", name)
+		}
+		return expr.(*regexp.Regexp), nil
+	}
+	if expr, ok := _compiled.Load(source); ok {
+		return expr.(*regexp.Regexp), nil
+	}
+	expr, err := regexp.Compile(source)
+	if err != nil {
+		return nil, err
+	}
+	_compiled.Store(source, expr)
+	return expr, nil
+}
@@ -0,0 +1,90 @@
+package govalidator
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_RegExp(t *testing.T) {
+	RegisterPattern("sku", regexp.MustCompile(`^[A-Z]{3}-\d{4}$`))
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "ad hoc pattern",
+			input: struct {
+				Code   string   `validate:"regexp(^[A-Z]{3}$)"`
+				Ref    *string  `validate:"regexp(^(AB|CD)[0-9]{2,4}$)"`
+				Phones []string `validate:"regexp(^\\(\\d{3}\\) \\d{4}$)"`
+			}{Code: "USD", Ref: stringPtr("CD123"), Phones: []string{"(213) 3000", "213 3000"}},
+			expectPaths: []string{"Phones[1]"},
+		},
+		{
+			description: "quoted pattern",
+			input: struct {
+				Name string `validate:"regexp('^[^,()]+$'),max(5)"`
+			}{Name: "a,b"},
+			expectPaths: []string{"Name"},
+		},
+		{
+			description: "named pattern",
+			input: struct {
+				SKU  string `validate:"regexp(@sku)"`
+				Bad  string `validate:"regexp(@sku)"`
+				Mail string `validate:"regexp(@\\w+)"`
+			}{SKU: "ABC-1234", Bad: "abc-1234", Mail: "@bob"},
+			expectPaths: []string{"Bad"},
+		},
+		{
+			description: "unregistered pattern",
+			input: struct {
+				SKU string `validate:"regexp(@upc_code)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unquoted pattern with comma",
+			input: struct {
+				Code string `validate:"regexp(^a,b$)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "invalid pattern",
+			input: struct {
+				Code string `validate:"regexp(^[A-Z$)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
+
+func TestLookupPattern_Cached(t *testing.T) {
+	first, err := lookupPattern("^cached[0-9]+$")
+	assert.Nil(t, err)
+	second, err := lookupPattern("^cached[0-9]+$")
+	assert.Nil(t, err)
+	assert.True(t, first == second)
+}
@@ -41,13 +41,9 @@ const (
 	rgbaRegexPattern                = "^rgba\\(\\s*(?:(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])|(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])%\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])%\\s*,\\s*(?:0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])%)\\s*,\\s*(?:(?:0.[1-9]*)|[01])\\s*\\)$"
 	hslRegexPattern                 = "^hsl\\(\\s*(?:0|[1-9]\\d?|[12]\\d\\d|3[0-5]\\d|360)\\s*,\\s*(?:(?:0|[1-9]\\d?|100)%)\\s*,\\s*(?:(?:0|[1-9]\\d?|100)%)\\s*\\)$"
 	hslaRegexPattern                = "^hsla\\(\\s*(?:0|[1-9]\\d?|[12]\\d\\d|3[0-5]\\d|360)\\s*,\\s*(?:(?:0|[1-9]\\d?|100)%)\\s*,\\s*(?:(?:0|[1-9]\\d?|100)%)\\s*,\\s*(?:(?:0.[1-9]*)|[01])\\s*\\)$"
-	emailRegexPattern               = "^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$"
 	e164RegexPattern                = "^\\+[1-9]?[0-9]{7,14}$"
 	localPhoneRegexPattern          = `^\(?\d{3}\)?[\s.-]\d{3}[\s.-]\d{4}$`
-	base64RegexPattern              = "^(?:[A-Za-z0-9+\\/]{4})*(?:[A-Za-z0-9+\\/]{2}==|[A-Za-z0-9+\\/]{3}=|[A-Za-z0-9+\\/]{4})$"
 	base64URLRegexPattern           = "^(?:[A-Za-z0-9-_]{4})*(?:[A-Za-z0-9-_]{2}==|[A-Za-z0-9-_]{3}=|[A-Za-z0-9-_]{4})$"
-	iSBN10RegexPattern              = "^(?:[0-9]{9}X|[0-9]{10})$"
-	iSBN13RegexPattern              = "^(?:(?:97(?:8|9))[0-9]{10})$"
 	uUID3RegexPattern               = "^[0-9a-f]{8}-[0-9a-f]{4}-3[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$"
 	uUID4RegexPattern               = "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
 	uUID5RegexPattern               = "^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
@@ -81,7 +77,6 @@ const (
 	uRLEncodedRegexPattern          = `^(?:[^%]|%[0-9A-Fa-f]{2})*$`
 	hTMLEncodedRegexPattern         = `&#[x]?([0-9a-fA-F]{2})|(&gt)|(&lt)|(&quot)|(&amp)+[;]?`
 	hTMLRegexPattern                = `<[/]?([a-zA-Z]+).*?>`
-	jWTRegexPattern                 = "^[A-Za-z0-9-_]+\\.[A-Za-z0-9-_]+\\.[A-Za-z0-9-_]*$"
 	bicRegexPattern                 = `^[A-Za-z]{6}[A-Za-z0-9]{2}([A-Za-z0-9]{3})?$`
 	dnsRegexPatternRFC1035Label     = "^[a-z]([-a-z0-9]*[a-z0-9]){0,62}$"
 
@@ -107,11 +102,7 @@ var (
 	hslaRegex                = regexp.MustCompile(hslaRegexPattern)
 	e164Regex                = regexp.MustCompile(e164RegexPattern)
 	localPhoneRegex          = regexp.MustCompile(localPhoneRegexPattern)
-	emailRegex               = regexp.MustCompile(emailRegexPattern)
-	base64Regex              = regexp.MustCompile(base64RegexPattern)
 	base64URLRegex           = regexp.MustCompile(base64URLRegexPattern)
-	iSBN10Regex              = regexp.MustCompile(iSBN10RegexPattern)
-	iSBN13Regex              = regexp.MustCompile(iSBN13RegexPattern)
 	uUID3Regex               = regexp.MustCompile(uUID3RegexPattern)
 	uUID4Regex               = regexp.MustCompile(uUID4RegexPattern)
 	uUID5Regex               = regexp.MustCompile(uUID5RegexPattern)
@@ -145,7 +136,6 @@ var (
 	uRLEncodedRegex          = regexp.MustCompile(uRLEncodedRegexPattern)
 	hTMLEncodedRegex         = regexp.MustCompile(hTMLEncodedRegexPattern)
 	hTMLRegex                = regexp.MustCompile(hTMLRegexPattern)
-	jWTRegex                 = regexp.MustCompile(jWTRegexPattern)
 	bicRegex                 = regexp.MustCompile(bicRegexPattern)
 	dnsRegexRFC1035Label     = regexp.MustCompile(dnsRegexPatternRFC1035Label)
 	iabCategory              = regexp.MustCompile(iabCategoryPattern)
@@ -6,8 +6,9 @@ import (
 )
 
 type registry struct {
-	fn    map[string]NewIsValid
-	alias map[string][]string
+	fn         map[string]NewIsValid
+	alias      map[string][]string
+	collection map[string]bool
 	sync.RWMutex
 }
 
@@ -34,6 +35,22 @@ func (r *registry) Alias(tag string) []string {
 	return ret
 }
 
+//RegisterCollection register collection tag
+func (r *registry) RegisterCollection(tag string, fn NewIsValid) {
+	r.RWMutex.Lock()
+	r.fn[tag] = fn
+	r.collection[tag] = true
+	r.RWMutex.Unlock()
+}
+
+//IsCollection returns true if tag validates collection as a whole
+func (r *registry) IsCollection(tag string) bool {
+	r.RWMutex.RLock()
+	ret := r.collection[tag]
+	r.RWMutex.RUnlock()
+	return ret
+}
+
 //RegisterAlias register tag alias
 func (r *registry) RegisterAlias(tag string, tags ...string) {
 	r.RWMutex.Lock()
@@ -41,13 +58,23 @@ func (r *registry) RegisterAlias(tag string, tags ...string) {
 	r.RWMutex.Unlock()
 }
 
-var _register = &registry{fn: map[string]NewIsValid{}, alias: map[string][]string{}}
+var _register = &registry{fn: map[string]NewIsValid{}, alias: map[string][]string{}, collection: map[string]bool{}}
 
 //Register register tag
 func Register(check string, fn NewIsValid) {
 	_register.Register(strings.ToLower(check), fn)
 }
 
+//RegisterCollection register check validating slice as a whole, for []string and []int fields it is not applied per element
+func RegisterCollection(check string, fn NewIsValid) {
+	_register.RegisterCollection(strings.ToLower(check), fn)
+}
+
+//IsCollection returns true if check validates slice as a whole
+func IsCollection(check string) bool {
+	return _register.IsCollection(strings.ToLower(check))
+}
+
 //RegisterAlias register tag alias
 func RegisterAlias(check string, checks ...string) {
 	_register.RegisterAlias(strings.ToLower(check), checks...)
@@ -71,7 +98,12 @@ func LookupAll(check string) NewIsValid {
 	}
 	var result []NewIsValid
 	for _, alias := range Alias(check) {
-		result = append(result, LookupAll(alias))
+		if newIsValid := LookupAll(alias); newIsValid != nil {
+			result = append(result, newIsValid)
+		}
+	}
+	if len(result) == 0 {
+		return nil
 	}
 	return atListOneValid(result...)
 }
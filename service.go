@@ -26,12 +26,12 @@ func (s *Service) Validate(ctx context.Context, any interface{}, opts ...Option)
 		rootPath.Path = options.Path
 	}
 	validation := &Validation{}
-	ctx = SessionContext(ctx, &Session{Path: rootPath})
+	ctx = SessionContext(ctx, &Session{Path: rootPath, Root: any, resolver: options.DomainResolver, fs: options.FS})
 
 	if err := s.validate(ctx, any, validation, options); err != nil {
 		return nil, err
 	}
-	validation.Failed = len(validation.Violations) > 0
+	validation.Failed = validation.HasErrors()
 	return validation, nil
 }
 
@@ -92,6 +92,10 @@ func (s *Service) validateStruct(ctx context.Context, t reflect.Type, value inte
 	if options.Shallow {
 		return nil
 	}
+	session.parents = append(session.parents, value)
+	defer func() {
+		session.parents = session.parents[:len(session.parents)-1]
+	}()
 	err = s.diveStructFields(ctx, checks, path, ptr, session, validation, options)
 	if err != nil {
 		return err
@@ -100,6 +104,10 @@ func (s *Service) validateStruct(ctx context.Context, t reflect.Type, value inte
 	if err != nil {
 		return err
 	}
+	err = s.diveMapFields(ctx, checks, path, ptr, session, validation, options)
+	if err != nil {
+		return err
+	}
 	err = s.diveSimpleSliceFields(ctx, checks, path, ptr, session, validation, options)
 	if err != nil {
 		return err
@@ -157,6 +165,53 @@ func (s *Service) diveSliceFields(ctx context.Context, checks *Checks, path *Pat
 	return nil
 }
 
+func (s *Service) diveMapFields(ctx context.Context, checks *Checks, path *Path, ptr unsafe.Pointer, session *Session, validation *Validation, options *Options) error {
+	if len(checks.Maps) == 0 {
+		return nil
+	}
+	for _, candidate := range checks.Maps {
+		fieldPath := path.Field(candidate.Name)
+		if candidate.SkipPath {
+			fieldPath = path
+		}
+		fieldValue := candidate.Value(ptr)
+		if fieldValue == nil {
+			continue
+		}
+		session.Set(fieldPath, candidate, fieldValue)
+		if err := s.validateMap(ctx, fieldValue, validation, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) validateMap(ctx context.Context, any interface{}, validation *Validation, options *Options) error {
+	mapValue, isNil := derefReflectValue(any)
+	if isNil {
+		return nil
+	}
+	session := ctx.Value(SessionKey).(*Session)
+	path, field, parentValue := session.Path, session.Field, session.ParentValue
+	defer session.Set(path, field, parentValue)
+	keys, names := sortedMapKeys(mapValue)
+	for i, key := range keys {
+		item := mapValue.MapIndex(key)
+		if item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				continue
+			}
+			item = item.Elem()
+		}
+		entryPath := path.Entry(names[i])
+		session.Set(entryPath, field, any)
+		if err := s.validateStruct(ctx, item.Type(), item.Interface(), validation, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Service) diveSimpleSliceFields(ctx context.Context, checks *Checks, path *Path, ptr unsafe.Pointer, session *Session, validation *Validation, options *Options) error {
 
 	if len(checks.SimpleSlices) == 0 {
@@ -197,6 +252,19 @@ func (s *Service) diveSimpleSliceFields(ctx context.Context, checks *Checks, pat
 					return err
 				}
 			}
+		default:
+			slice := reflect.ValueOf(fieldValue)
+			if slice.Kind() != reflect.Slice {
+				continue
+			}
+			for j := 0; j < slice.Len(); j++ {
+				item := slice.Index(j).Interface()
+				elemPath := fieldPath.Element(j)
+				session.Set(elemPath, candidate, item)
+				if err := s.checkValue(ctx, fieldCheck, item, options, validation, elemPath); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -213,11 +281,21 @@ func (s *Service) checkStructFields(ctx context.Context, checks *Checks, path *P
 		canUseMarker = options.CanUseMarkerProvider(value)
 	}
 
+	var err error
 	for _, field := range checks.Fields {
 		fieldPath := path.Field(field.Field.Name)
 		fieldValue := field.Field.Value(ptr)
-
-		if isEmpty(fieldValue) && field.Omitempty {
+		empty := false
+		if field.unwrapper != nil {
+			valid := false
+			if fieldValue, valid, err = field.unwrapper.Unwrap(fieldValue); err != nil {
+				return err
+			}
+			empty = !valid
+		} else {
+			empty = isEmpty(fieldValue)
+		}
+		if empty && field.Omitempty {
 			continue
 		}
 
@@ -228,8 +306,7 @@ func (s *Service) checkStructFields(ctx context.Context, checks *Checks, path *P
 		}
 
 		session.Set(path, field.Field, value)
-		err := s.checkValue(ctx, field, fieldValue, options, validation, fieldPath)
-		if err != nil {
+		if err = s.checkValue(ctx, field, fieldValue, options, validation, fieldPath); err != nil {
 			return err
 		}
 	}
@@ -237,20 +314,52 @@ func (s *Service) checkStructFields(ctx context.Context, checks *Checks, path *P
 }
 
 func (s *Service) checkValue(ctx context.Context, field *FieldCheck, fieldValue interface{}, options *Options, validation *Validation, fieldPath *Path) error {
+	session, _ := ctx.Value(SessionKey).(*Session)
 	for i, isValid := range field.IsValid {
+		session.resetFailures()
 		passed, err := isValid(ctx, fieldValue)
 		if err != nil {
 			return err
 		}
-		if !passed {
-			if field.Type.Kind() == reflect.Ptr && !options.PreservePointer {
-				if isNil := isNil(fieldValue); isNil {
-					fieldValue = nil
-				} else {
-					fieldValue = deref(fieldValue)
+		if passed {
+			continue
+		}
+		check := &field.Checks[i]
+		if failures := session.takeFailures(); len(failures) > 0 {
+			for _, failure := range failures {
+				value := failure.value
+				if field.Sensitive || options.Sensitive {
+					value = RedactedValue
 				}
+				reported := check
+				if failure.reason != "" && check.Message == "" && field.Messages[i] == nil {
+					withReason := *check
+					withReason.Message = failure.reason
+					reported = &withReason
+				}
+				if err = validation.AppendCheck(failure.location(fieldPath), field.Field.Name, value, reported, field.Messages[i]); err != nil {
+					return err
+				}
+			}
+			if check.Severity.IsError() {
+				break
+			}
+			continue
+		}
+		value := fieldValue
+		if field.Sensitive || options.Sensitive {
+			value = RedactedValue
+		} else if field.unwrapper == nil && field.Type.Kind() == reflect.Ptr && !options.PreservePointer {
+			if isNil := isNil(value); isNil {
+				value = nil
+			} else {
+				value = deref(value)
 			}
-			validation.Append(fieldPath, field.Field.Name, fieldValue, field.Checks[i].Name, field.Checks[i].Message, field.Checks[i].Parameters)
+		}
+		if err = validation.AppendCheck(fieldPath, field.Field.Name, value, check, field.Messages[i]); err != nil {
+			return err
+		}
+		if check.Severity.IsError() {
 			break
 		}
 	}
@@ -326,11 +435,19 @@ func isEmpty(value interface{}) bool {
 	if value == nil {
 		return true
 	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return true
+		}
+	case reflect.Map, reflect.Slice:
+		return rv.Len() == 0
+	}
 	if zeroer, ok := value.(Zeroable); ok {
 		return zeroer.IsZero()
 	}
-	return value == nil
-
+	return false
 }
 
 func isNil(value interface{}) bool {
@@ -348,6 +465,9 @@ func isNil(value interface{}) bool {
 		if value == nil {
 			return true
 		}
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && rv.IsNil() { //typed nil pointer cannot call value receiver IsZero
+			return true
+		}
 		if zeroer, ok := value.(Zeroable); ok {
 			return zeroer.IsZero()
 		}
@@ -774,3 +774,200 @@ func getTime(timeStr string) time.Time {
 	}
 	return r
 }
+
+func TestService_Validate_MessageTemplate(t *testing.T) {
+	validation, err := New().Validate(context.Background(), struct {
+		Name string `validate:"min(3),message={{.Field}} must be at least {{index .Params 0}} characters"`
+	}{Name: "ab"})
+	if !assert.Nil(t, err) {
+		return
+	}
+	if assert.Equal(t, 1, len(validation.Violations)) {
+		assert.Equal(t, "Name must be at least 3 characters", validation.Violations[0].Message)
+	}
+
+	_, err = New().Validate(context.Background(), struct {
+		Name string `validate:"min(3),message={{.Field"`
+	}{Name: "ab"})
+	assert.NotNil(t, err)
+}
+
+func TestService_Validate_Severity(t *testing.T) {
+	type Record struct {
+		ID   int    `validate:"required"`
+		Name string `validate:"required,max(5),severity=warning,contains(x),severity=info"`
+	}
+	validation, err := New().Validate(context.Background(), &Record{ID: 1, Name: "abcdefg"})
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.False(t, validation.Failed)
+	if assert.Equal(t, 2, len(validation.Violations)) {
+		assert.Equal(t, SeverityWarning, validation.Violations[0].Severity)
+		assert.Equal(t, SeverityInfo, validation.Violations[1].Severity)
+	}
+	assert.Equal(t, 1, len(validation.BySeverity(SeverityWarning)))
+
+	validation, err = New().Validate(context.Background(), &Record{Name: "abcdefg"})
+	if !assert.Nil(t, err) {
+		return
+	}
+	assert.True(t, validation.Failed)
+	assert.Equal(t, 1, len(validation.BySeverity(SeverityError)))
+
+	_, err = New().Validate(context.Background(), struct {
+		Name string `validate:"max(5),severity=fatal"`
+	}{})
+	assert.NotNil(t, err)
+}
+
+func TestService_Validate_Sensitive(t *testing.T) {
+	type Credentials struct {
+		User     string `validate:"min(3),message=$field '$value' is too short"`
+		Password string `validate:"sensitive,min(8),message=$field '$value' is too short"`
+		Token    string `validate:"sensitive,min(8),message={{.Field}} {{.Value}} is too short"`
+	}
+	validation, err := New().Validate(context.Background(), &Credentials{User: "ab", Password: "secret", Token: "abc"})
+	if !assert.Nil(t, err) || !assert.Equal(t, 3, len(validation.Violations)) {
+		return
+	}
+	assert.Equal(t, "ab", validation.Violations[0].Value)
+	assert.Equal(t, "User 'ab' is too short", validation.Violations[0].Message)
+	assert.Equal(t, RedactedValue, validation.Violations[1].Value)
+	assert.Equal(t, "Password '[REDACTED]' is too short", validation.Violations[1].Message)
+	assert.Equal(t, RedactedValue, validation.Violations[2].Value)
+	assert.Equal(t, "Token [REDACTED] is too short", validation.Violations[2].Message)
+
+	validation, err = New().Validate(context.Background(), &Credentials{User: "ab", Password: "secret123", Token: "abcdefghi"}, WithSensitive(true))
+	if assert.Nil(t, err) && assert.Equal(t, 1, len(validation.Violations)) {
+		assert.Equal(t, RedactedValue, validation.Violations[0].Value)
+		assert.Equal(t, "User '[REDACTED]' is too short", validation.Violations[0].Message)
+	}
+}
+
+func TestService_Validate_ConditionalChecks(t *testing.T) {
+	var tests = []struct {
+		description  string
+		input        interface{}
+		expectFailed bool
+		expectErr    bool
+	}{
+		{
+			description: "required_if multi pair enforced",
+			input: struct {
+				Type    string
+				Country string
+				Tax     string `validate:"required_if(Type,business,Country,US)"`
+			}{Type: "business", Country: "US"},
+			expectFailed: true,
+		},
+		{
+			description: "required_if multi pair partially matched",
+			input: struct {
+				Type    string
+				Country string
+				Tax     string `validate:"required_if(Type,business,Country,US)"`
+			}{Type: "business", Country: "CA"},
+		},
+		{
+			description: "required_if value set",
+			input: struct {
+				Type  string
+				Phone string `validate:"required_if(Type,mobile|landline)"`
+			}{Type: "landline"},
+			expectFailed: true,
+		},
+		{
+			description: "required_unless value set",
+			input: struct {
+				Kind  int
+				Phone string `validate:"required_unless(Kind,1|2)"`
+			}{Kind: 2},
+		},
+		{
+			description: "required_with_all skipped when one is missing",
+			input: struct {
+				Street string
+				City   string
+				Zip    string `validate:"required_with_all(Street,City)"`
+			}{Street: "Main"},
+		},
+		{
+			description: "required_with_all enforced",
+			input: struct {
+				Street string
+				City   string
+				Zip    string `validate:"required_with_all(Street,City)"`
+			}{Street: "Main", City: "LA"},
+			expectFailed: true,
+		},
+		{
+			description: "required_without_all skipped when one is present",
+			input: struct {
+				Email string
+				Phone *string
+				Fax   string `validate:"required_without_all(Email,Phone)"`
+			}{Phone: stringPtr("1")},
+		},
+		{
+			description: "required_without_all enforced",
+			input: struct {
+				Email string
+				Phone *string
+				Fax   string `validate:"required_without_all(Email,Phone)"`
+			}{},
+			expectFailed: true,
+		},
+		{
+			description: "excluded_if enforced",
+			input: struct {
+				Type   string
+				Reason string `validate:"excluded_if(Type,approved)"`
+			}{Type: "approved", Reason: "x"},
+			expectFailed: true,
+		},
+		{
+			description: "excluded_unless enforced",
+			input: struct {
+				Status string
+				Reason string `validate:"excluded_unless(Status,rejected|cancelled)"`
+			}{Status: "approved", Reason: "x"},
+			expectFailed: true,
+		},
+		{
+			description: "excluded_unless skipped",
+			input: struct {
+				Status string
+				Reason string `validate:"excluded_unless(Status,rejected|cancelled)"`
+			}{Status: "cancelled", Reason: "x"},
+		},
+		{
+			description: "excluded_with enforced",
+			input: struct {
+				CardNumber string
+				IBAN       string `validate:"excluded_with(CardNumber)"`
+			}{CardNumber: "4111", IBAN: "DE"},
+			expectFailed: true,
+		},
+		{
+			description: "odd condition parameters",
+			input: struct {
+				Type  string
+				Phone string `validate:"required_if(Type,mobile,Country)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		validation, err := New().Validate(context.Background(), test.input)
+		if test.expectErr {
+			assert.NotNil(t, err, test.description)
+			continue
+		}
+		if !assert.Nil(t, err, test.description) {
+			continue
+		}
+		assert.EqualValues(t, test.expectFailed, validation.Failed, test.description)
+	}
+}
@@ -1,6 +1,9 @@
 package govalidator
 
-import "context"
+import (
+	"context"
+	"io/fs"
+)
 
 type (
 
@@ -9,6 +12,21 @@ type (
 		Path        *Path
 		Field       *Field
 		ParentValue interface{}
+		Root        interface{}
+		parents     []interface{}
+		failures    []*elementFailure
+		resolver    DomainResolver
+		fs          fs.FS
+	}
+
+	//elementFailure represents collection element, map entry or nested value that failed a check
+	elementFailure struct {
+		index   int
+		key     string
+		isEntry bool
+		nested  *Path
+		reason  string
+		value   interface{}
 	}
 )
 
@@ -18,6 +36,53 @@ func (s *Session) Set(path *Path, field *Field, parentValue interface{}) {
 	s.ParentValue = parentValue
 }
 
+func (s *Session) resetFailures() {
+	if s != nil {
+		s.failures = s.failures[:0]
+	}
+}
+
+func (s *Session) takeFailures() []*elementFailure {
+	if s == nil || len(s.failures) == 0 {
+		return nil
+	}
+	ret := s.failures
+	s.failures = nil
+	return ret
+}
+
+func (f *elementFailure) location(fieldPath *Path) *Path {
+	if f.nested != nil {
+		return fieldPath.join(f.nested)
+	}
+	if f.isEntry {
+		return fieldPath.Entry(f.key)
+	}
+	return fieldPath.Element(f.index)
+}
+
+//reportElement records collection element failing current check, violation is reported at element path instead of the field path
+func reportElement(ctx context.Context, index int, value interface{}) {
+	reportFailure(ctx, &elementFailure{index: index, value: value})
+}
+
+//reportEntry records map entry failing current check, violation is reported at entry path instead of the field path
+func reportEntry(ctx context.Context, key string, value interface{}) {
+	reportFailure(ctx, &elementFailure{key: key, isEntry: true, value: value})
+}
+
+//reportNested records value nested in the field (i.e. JSON payload property) failing current check, violation is reported at
+//field path joined with relative path, reason is used as violation message unless check defines one
+func reportNested(ctx context.Context, relative *Path, value interface{}, reason string) {
+	reportFailure(ctx, &elementFailure{nested: relative, value: value, reason: reason})
+}
+
+func reportFailure(ctx context.Context, failure *elementFailure) {
+	if session, ok := ctx.Value(SessionKey).(*Session); ok && session != nil {
+		session.failures = append(session.failures, failure)
+	}
+}
+
 //SessionKey represents a session key
 var SessionKey string
 
@@ -11,6 +11,7 @@ type (
 		Omitempty bool
 		Required  bool
 		SkipPath  bool
+		Sensitive bool
 	}
 
 	//Check represents validation check
@@ -18,6 +19,7 @@ type (
 		Name       string
 		Parameters []string
 		Message    string
+		Severity   Severity
 	}
 )
 
@@ -35,12 +37,22 @@ func ParseTag(tagString string) *Tag {
 
 	for _, element := range elements {
 		check := Check{}
-		pair := strings.Split(element, "=")
+		pair := splitAssignment(element)
 		switch len(pair) {
 		case 2:
 			switch strings.ToLower(strings.TrimSpace(pair[0])) {
 			case "message":
 				check.Message = strings.TrimSpace(pair[1])
+				if count := len(tag.Checks); count > 0 { //message modifies preceding check
+					tag.Checks[count-1].Message = check.Message
+					continue
+				}
+			case "severity":
+				check.Severity = Severity(strings.ToLower(strings.TrimSpace(pair[1])))
+				if count := len(tag.Checks); count > 0 { //severity modifies preceding check
+					tag.Checks[count-1].Severity = check.Severity
+					continue
+				}
 			case "name":
 				check.Name, check.Parameters = extractNameWithParams(strings.TrimSpace(pair[1]))
 			default:
@@ -53,39 +65,62 @@ func ParseTag(tagString string) *Tag {
 		switch strings.ToLower(check.Name) {
 		case "omitempty", "skippath", "marker":
 			continue
+		case "sensitive":
+			tag.Sensitive = true
+			continue
 		}
 		tag.Checks = append(tag.Checks, check)
 	}
 	return tag
 }
 
+//splitAssignment splits key=value element, unless '=' is used within check parameters or message template
+func splitAssignment(element string) []string {
+	index := strings.Index(element, "=")
+	if index == -1 {
+		return []string{element}
+	}
+	if paren := strings.Index(element, "("); paren != -1 && paren < index {
+		return []string{element}
+	}
+	if brace := strings.Index(element, "{{"); brace != -1 && brace < index {
+		return []string{element}
+	}
+	return []string{element[:index], element[index+1:]}
+}
+
+// extractElements splits tag into check elements by ',' or '|', separators within check parameters (nested parentheses included),
+// quoted parameters, escaped characters and message templates are preserved
 func extractElements(decoded string) []string {
 	var result []string
-
-	var fragment = ""
-	var inCall bool
-	for i, c := range decoded {
+	scanner := &tagScanner{}
+	begin := 0
+	var inTemplate bool
+	for i := 0; i < len(decoded); i++ {
+		if inTemplate {
+			if decoded[i] == '}' && i > 0 && decoded[i-1] == '}' {
+				inTemplate = false
+			}
+			continue
+		}
+		if !scanner.structural(decoded, &i) {
+			continue
+		}
 		switch decoded[i] {
+		case '{':
+			if scanner.depth == 0 && i+1 < len(decoded) && decoded[i+1] == '{' {
+				inTemplate = true
+			}
 		case ',', '|':
-			if inCall {
-				fragment += string(c)
+			if scanner.depth > 0 {
 				continue
 			}
-			result = append(result, fragment)
-			fragment = ""
-		case '(':
-			fragment += string(c)
-			inCall = true
-
-		case ')':
-			fragment += string(c)
-			inCall = false
-		default:
-			fragment += string(c)
+			result = append(result, decoded[begin:i])
+			begin = i + 1
 		}
 	}
-	if fragment != "" {
-		result = append(result, fragment)
+	if begin < len(decoded) {
+		result = append(result, decoded[begin:])
 	}
 	return result
 }
@@ -103,9 +138,119 @@ func extractNameWithParams(text string) (string, []string) {
 	if index != -1 {
 		argsFragment = argsFragment[:index]
 	}
+	return name, splitParams(argsFragment)
+}
+
+// splitParams splits check parameters by ',' outside of (), [], {} and quotes, i.e. regexp(^[A-Z]{3,5}$) or datetime('Mon, 02 Jan 2006')
+func splitParams(argsFragment string) []string {
 	var params []string
-	for _, item := range strings.Split(argsFragment, ",") {
-		params = append(params, strings.TrimSpace(item))
+	scanner := &tagScanner{bracketing: true, param: true}
+	begin := 0
+	for i := 0; i < len(argsFragment); i++ {
+		if scanner.structural(argsFragment, &i) && argsFragment[i] == ',' && scanner.depth == 0 {
+			params = append(params, unquoteParam(argsFragment[begin:i]))
+			begin = i + 1
+		}
+	}
+	return append(params, unquoteParam(argsFragment[begin:]))
+}
+
+// unquoteParam trims parameter, single quoted parameter is taken verbatim with \' unescaped
+func unquoteParam(param string) string {
+	param = strings.TrimSpace(param)
+	if len(param) >= 2 && param[0] == '\'' && param[len(param)-1] == '\'' {
+		return strings.Replace(param[1:len(param)-1], "\\'", "'", -1)
+	}
+	return param
+}
+
+// tagScanner tracks nesting depth, quoted parameters, character classes and escaped characters while scanning tag
+type tagScanner struct {
+	depth      int
+	quoted     bool
+	bracketing bool
+	param      bool
+	class      bool
+	classBegin int
+}
+
+// structural returns true if character at index is not quoted nor escaped, escaped character is skipped
+func (s *tagScanner) structural(text string, index *int) bool {
+	c := text[*index]
+	if s.quoted {
+		switch c {
+		case '\\':
+			*index++
+		case '\'':
+			s.quoted = false
+		}
+		return false
+	}
+	if s.class {
+		return s.classStructural(text, index)
+	}
+	atParamStart := s.param
+	if c != ' ' {
+		s.param = false
+	}
+	switch c {
+	case '\\':
+		*index++
+		return false
+	case '\'':
+		if atParamStart {
+			s.quoted = true
+			return false
+		}
+	case '(':
+		s.depth++
+		s.param = true
+	case ')':
+		if s.depth > 0 {
+			s.depth--
+		}
+	case '[':
+		if s.bracketing {
+			s.depth++
+		}
+		if s.bracketing || s.depth > 0 {
+			s.class = true
+			s.classBegin = *index + 1
+		}
+	case '{':
+		if s.bracketing {
+			s.depth++
+		}
+	case ']', '}':
+		if s.bracketing && s.depth > 0 {
+			s.depth--
+		}
+	case ',':
+		s.param = s.depth == 1 || (s.bracketing && s.depth == 0)
+	}
+	return true
+}
+
+// classStructural scans regular expression character class, i.e. [(,] or [^]a-z[:digit:]], only closing ']' is structural
+func (s *tagScanner) classStructural(text string, index *int) bool {
+	switch text[*index] {
+	case '\\':
+		*index++
+	case '[':
+		if *index+1 < len(text) && text[*index+1] == ':' {
+			if end := strings.Index(text[*index:], ":]"); end != -1 {
+				*index += end + 1
+			}
+		}
+	case ']':
+		if *index == s.classBegin || (*index == s.classBegin+1 && text[s.classBegin] == '^') {
+			return false
+		}
+		s.class = false
+		if s.bracketing && s.depth > 0 {
+			s.depth--
+		}
+		return true
 	}
-	return name, params
+	return false
 }
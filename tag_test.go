@@ -27,6 +27,68 @@ func TestParseTag(t *testing.T) {
 			tag:         "omitempty|checkX(param1, param2)",
 			expect:      &Tag{Omitempty: true, Checks: []Check{{Name: "checkX", Parameters: []string{"param1", "param2"}}}},
 		},
+		{
+			description: "message template modifies preceding check",
+			tag:         "min(3),message={{.Field}} needs {{index .Params 0}} not {{.Value | printf \"%q\"}},max(5)",
+			expect: &Tag{Checks: []Check{
+				{Name: "min", Parameters: []string{"3"}, Message: "{{.Field}} needs {{index .Params 0}} not {{.Value | printf \"%q\"}}"},
+				{Name: "max", Parameters: []string{"5"}},
+			}},
+		},
+		{
+			description: "severity modifies preceding check",
+			tag:         "required,max(100),severity=Warning",
+			expect: &Tag{Required: true, Checks: []Check{
+				{Name: "required", Parameters: emptyArgs},
+				{Name: "max", Parameters: []string{"100"}, Severity: SeverityWarning},
+			}},
+		},
+		{
+			description: "severity without preceding check",
+			tag:         "severity=warning,max(100)",
+			expect: &Tag{Checks: []Check{
+				{Severity: SeverityWarning},
+				{Name: "max", Parameters: []string{"100"}},
+			}},
+		},
+		{
+			description: "nested parentheses and commas in braces",
+			tag:         "omitempty,regexp(^(AB|CD)[0-9]{2,4}$),max(10)",
+			expect: &Tag{Omitempty: true, Checks: []Check{
+				{Name: "regexp", Parameters: []string{"^(AB|CD)[0-9]{2,4}$"}},
+				{Name: "max", Parameters: []string{"10"}},
+			}},
+		},
+		{
+			description: "escaped parentheses",
+			tag:         `regexp(^\(\d+\)$)|required`,
+			expect: &Tag{Required: true, Checks: []Check{
+				{Name: "regexp", Parameters: []string{`^\(\d+\)$`}},
+				{Name: "required", Parameters: emptyArgs},
+			}},
+		},
+		{
+			description: "quoted parameters",
+			tag:         `datetime('Mon, 02 Jan 2006'),regexp('^[(]x\'s$', ' a,b ')`,
+			expect: &Tag{Checks: []Check{
+				{Name: "datetime", Parameters: []string{"Mon, 02 Jan 2006"}},
+				{Name: "regexp", Parameters: []string{`^[(]x's$`, " a,b "}},
+			}},
+		},
+		{
+			description: "parentheses and commas in character class",
+			tag:         `regexp(^[(]$),max(5),regexp([^],)][[:alpha:]]+)`,
+			expect: &Tag{Checks: []Check{
+				{Name: "regexp", Parameters: []string{"^[(]$"}},
+				{Name: "max", Parameters: []string{"5"}},
+				{Name: "regexp", Parameters: []string{"[^],)][[:alpha:]]+"}},
+			}},
+		},
+		{
+			description: "sensitive modifier",
+			tag:         "sensitive,min(8)",
+			expect:      &Tag{Sensitive: true, Checks: []Check{{Name: "min", Parameters: []string{"8"}}}},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,142 @@
+package govalidator
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NewNFC creates check that text is in Unicode normalization form C
+func NewNFC() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("nfc", func(actual string) bool {
+		return utf8.ValidString(actual) && norm.NFC.IsNormalString(actual)
+	})
+}
+
+// NewNFKC creates check that text is in Unicode normalization form KC, compatibility characters (i.e. ligatures, full width forms) fail
+func NewNFKC() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("nfkc", func(actual string) bool {
+		return utf8.ValidString(actual) && norm.NFKC.IsNormalString(actual)
+	})
+}
+
+// NewNoControl creates check that text has no control characters (Unicode Cc category, including tab and new line) and is valid UTF-8
+func NewNoControl() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("nocontrol", func(actual string) bool {
+		return isRunes(actual, func(r rune) bool {
+			return !unicode.IsControl(r)
+		})
+	})
+}
+
+// NewPrintable creates check that text consists of printable Unicode characters: letters, marks, numbers, punctuation, symbols and ASCII space
+func NewPrintable() func(field *Field, check *Check) (IsValid, error) {
+	return newFormatCheck("printable", func(actual string) bool {
+		return isRunes(actual, unicode.IsPrint)
+	})
+}
+
+// NewMaxBytes creates text length in UTF-8 bytes check, as opposed to rune based max, i.e. maxbytes(255)
+func NewMaxBytes() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, 1); err != nil {
+			return nil, err
+		}
+		limit, err := parseTextLength(check, check.Parameters[0])
+		if err != nil {
+			return nil, err
+		}
+		return newFormatCheck("maxbytes", func(actual string) bool {
+			return len(actual) <= limit
+		})(field, check)
+	}
+}
+
+// NewGraphemes creates text length in user perceived characters (extended grapheme clusters) check, i.e. graphemes(1,40)
+func NewGraphemes() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 2, 2); err != nil {
+			return nil, err
+		}
+		min, err := parseTextLength(check, check.Parameters[0])
+		if err != nil {
+			return nil, err
+		}
+		max, err := parseTextLength(check, check.Parameters[1])
+		if err != nil {
+			return nil, err
+		}
+		if min > max {
+			return nil, NewInvalidParameterError(check, check.Parameters[0], "expected min not greater than max", nil)
+		}
+		return newFormatCheck("graphemes", func(actual string) bool {
+			if !utf8.ValidString(actual) {
+				return false
+			}
+			count := uniseg.GraphemeClusterCount(actual)
+			return count >= min && count <= max
+		})(field, check)
+	}
+}
+
+// NewScript creates check that letters belong to one of listed Unicode scripts, script names are matched case insensitively, i.e. script(Latin,Cyrillic);
+// characters shared across scripts (Common and Inherited: digits, punctuation, spaces, combining marks) are always allowed
+func NewScript() func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		if err := expectParameters(check, 1, -1); err != nil {
+			return nil, err
+		}
+		tables := []*unicode.RangeTable{unicode.Common, unicode.Inherited}
+		for _, param := range check.Parameters {
+			table, ok := lookupScript(param)
+			if !ok {
+				return nil, NewInvalidParameterError(check, param, "expected Unicode script name, i.e. Latin", nil)
+			}
+			tables = append(tables, table)
+		}
+		return newFormatCheck("script", func(actual string) bool {
+			return isRunes(actual, func(r rune) bool {
+				return unicode.IsOneOf(tables, r)
+			})
+		})(field, check)
+	}
+}
+
+// isRunes returns true if text is valid UTF-8 and each rune satisfies predicate
+func isRunes(actual string, predicate func(r rune) bool) bool {
+	if !utf8.ValidString(actual) {
+		return false
+	}
+	for _, r := range actual {
+		if !predicate(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseTextLength(check *Check, param string) (int, error) {
+	value, err := strconv.Atoi(strings.TrimSpace(param))
+	if err != nil || value < 0 {
+		return 0, NewInvalidParameterError(check, param, "expected non negative integer", err)
+	}
+	return value, nil
+}
+
+// lookupScript returns unicode.Scripts table by case insensitive name
+func lookupScript(name string) (*unicode.RangeTable, bool) {
+	name = strings.TrimSpace(name)
+	if table, ok := unicode.Scripts[name]; ok {
+		return table, true
+	}
+	for candidate, table := range unicode.Scripts {
+		if strings.EqualFold(candidate, name) {
+			return table, true
+		}
+	}
+	return nil, false
+}
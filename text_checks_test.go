@@ -0,0 +1,111 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_TextChecks(t *testing.T) {
+	var testCases = []struct {
+		description string
+		input       interface{}
+		expectPaths []string
+		expectErr   bool
+	}{
+		{
+			description: "normalization forms",
+			input: struct {
+				Composed    string   `validate:"nfc"`
+				Decomposed  string   `validate:"nfc"`
+				Compatible  string   `validate:"nfkc"`
+				Ligatures   *string  `validate:"nfkc"`
+				Identifiers []string `validate:"nfkc"`
+			}{Composed: "café", Decomposed: "café", Compatible: "office", Ligatures: stringPtr("oﬃce"), Identifiers: []string{"Ｆｕｌｌ", "full", "\xff"}},
+			expectPaths: []string{"Decomposed", "Ligatures", "Identifiers[0]", "Identifiers[2]"},
+		},
+		{
+			description: "nocontrol and printable",
+			input: struct {
+				Names     []string `validate:"nocontrol"`
+				Labels    []string `validate:"printable"`
+				Signature string   `validate:"printable"`
+			}{Names: []string{"Zoë Łódź", "tab\tseparated", "bell\a", "soft­hyphen"}, Labels: []string{"Привет, мир!", "東京 2024", "line\nbreak", "zero​width"}, Signature: " "},
+			expectPaths: []string{"Signature", "Names[1]", "Names[2]", "Labels[2]", "Labels[3]"},
+		},
+		{
+			description: "maxbytes versus max",
+			input: struct {
+				Runes string `validate:"max(4)"`
+				Bytes string `validate:"maxbytes(4)"`
+				ASCII string `validate:"maxbytes(4)"`
+			}{Runes: "żółw", Bytes: "żółw", ASCII: "turt"},
+			expectPaths: []string{"Bytes"},
+		},
+		{
+			description: "graphemes",
+			input: struct {
+				Flags    string   `validate:"graphemes(1,2)"`
+				Family   string   `validate:"graphemes(1,1)"`
+				Accented string   `validate:"graphemes(4,4)"`
+				Names    []string `validate:"graphemes(2,3)"`
+			}{Flags: "🇵🇱🇺🇦", Family: "👨‍👩‍👧", Accented: "café", Names: []string{"Zoë", "A", "Łucja"}},
+			expectPaths: []string{"Names[1]", "Names[2]"},
+		},
+		{
+			description: "script",
+			input: struct {
+				Latin    string   `validate:"script(Latin)"`
+				Mixed    string   `validate:"script(latin)"`
+				Names    []string `validate:"script(Latin,Cyrillic)"`
+				Combined string   `validate:"script(Latin)"`
+			}{Latin: "Zoë O'Neil-Smith 3rd", Mixed: "pаypal", Names: []string{"Иван Petrov", "Ελένη"}, Combined: "café"},
+			expectPaths: []string{"Mixed", "Names[1]"},
+		},
+		{
+			description: "unknown script",
+			input: struct {
+				Name string `validate:"script(Klingon)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "invalid graphemes range",
+			input: struct {
+				Name string `validate:"graphemes(5,1)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "invalid maxbytes",
+			input: struct {
+				Name string `validate:"maxbytes(-1)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				Name int `validate:"nfc"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
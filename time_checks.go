@@ -0,0 +1,273 @@
+package govalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const layoutParameter = "layout="
+
+var (
+	defaultTimeLayouts    = []string{time.RFC3339Nano, time.RFC3339, "2006-01-02"}
+	relativeDurationRegex = regexp.MustCompile("([0-9]+(?:\\.[0-9]+)?)(ns|us|µs|ms|s|m|h|d|w)")
+	workDays              = map[time.Weekday]bool{time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true}
+)
+
+type (
+	//timeExpr represents absolute time or time relative to now, i.e. 2020-01-01, now, now+24h, -30d
+	timeExpr struct {
+		at       time.Time
+		relative bool
+		offset   time.Duration
+	}
+
+	timeCheck struct {
+		from    *timeExpr
+		to      *timeExpr
+		days    map[time.Weekday]bool
+		other   *fieldRef
+		layouts []string
+	}
+)
+
+func (e *timeExpr) resolve(now time.Time) time.Time {
+	if e.relative {
+		return now.Add(e.offset)
+	}
+	return e.at
+}
+
+func (c *timeCheck) after(ctx context.Context, value interface{}) (bool, error) {
+	actual, ok := toTimeValue(value, c.layouts)
+	if !ok {
+		return false, nil
+	}
+	return actual.After(c.from.resolve(time.Now())), nil
+}
+
+func (c *timeCheck) before(ctx context.Context, value interface{}) (bool, error) {
+	actual, ok := toTimeValue(value, c.layouts)
+	if !ok {
+		return false, nil
+	}
+	return actual.Before(c.to.resolve(time.Now())), nil
+}
+
+func (c *timeCheck) within(ctx context.Context, value interface{}) (bool, error) {
+	actual, ok := toTimeValue(value, c.layouts)
+	if !ok {
+		return false, nil
+	}
+	now := time.Now()
+	return !actual.Before(c.from.resolve(now)) && !actual.After(c.to.resolve(now)), nil
+}
+
+func (c *timeCheck) weekday(ctx context.Context, value interface{}) (bool, error) {
+	actual, ok := toTimeValue(value, c.layouts)
+	if !ok {
+		return false, nil
+	}
+	return c.days[actual.Weekday()], nil
+}
+
+func (c *timeCheck) afterTime(ctx context.Context, value interface{}) (bool, error) {
+	actual, ok := toTimeValue(value, c.layouts)
+	if !ok {
+		return false, nil
+	}
+	otherValue, err := c.other.Value(ctx)
+	if err != nil {
+		return false, err
+	}
+	if isEmpty(otherValue) {
+		return true, nil
+	}
+	other, ok := toTimeValue(otherValue, c.layouts)
+	if !ok {
+		return false, nil
+	}
+	return actual.After(other), nil
+}
+
+// NewAfter creates after(time expression) check, i.e. after(2020-01-01) or after(now-1h)
+func NewAfter() func(field *Field, check *Check) (IsValid, error) {
+	return newTimeCheck(1, 1, func(c *timeCheck, field *Field, check *Check, params []string) (IsValid, error) {
+		var err error
+		if c.from, err = newTimeExprParam(check, params[0], c.layouts); err != nil {
+			return nil, err
+		}
+		return c.after, nil
+	})
+}
+
+// NewBefore creates before(time expression) check, i.e. before(now+24h)
+func NewBefore() func(field *Field, check *Check) (IsValid, error) {
+	return newTimeCheck(1, 1, func(c *timeCheck, field *Field, check *Check, params []string) (IsValid, error) {
+		var err error
+		if c.to, err = newTimeExprParam(check, params[0], c.layouts); err != nil {
+			return nil, err
+		}
+		return c.before, nil
+	})
+}
+
+// NewWithin creates within(from,to) inclusive range check, i.e. within(-30d,+1h)
+func NewWithin() func(field *Field, check *Check) (IsValid, error) {
+	return newTimeCheck(2, 2, func(c *timeCheck, field *Field, check *Check, params []string) (IsValid, error) {
+		var err error
+		if c.from, err = newTimeExprParam(check, params[0], c.layouts); err != nil {
+			return nil, err
+		}
+		if c.to, err = newTimeExprParam(check, params[1], c.layouts); err != nil {
+			return nil, err
+		}
+		return c.within, nil
+	})
+}
+
+// NewWeekday creates weekday check, Monday to Friday by default, or listed days, i.e. weekday(sat,sun)
+func NewWeekday() func(field *Field, check *Check) (IsValid, error) {
+	return newTimeCheck(0, -1, func(c *timeCheck, field *Field, check *Check, params []string) (IsValid, error) {
+		if len(params) == 0 {
+			c.days = workDays
+			return c.weekday, nil
+		}
+		c.days = map[time.Weekday]bool{}
+		for _, param := range params {
+			day, ok := parseWeekday(param)
+			if !ok {
+				return nil, NewInvalidParameterError(check, param, "expected weekday", nil)
+			}
+			c.days[day] = true
+		}
+		return c.weekday, nil
+	})
+}
+
+// NewAfterTime creates aftertime(OtherField) check, empty other field is not compared
+func NewAfterTime() func(field *Field, check *Check) (IsValid, error) {
+	return newTimeCheck(1, 1, func(c *timeCheck, field *Field, check *Check, params []string) (IsValid, error) {
+		var err error
+		if c.other, err = newFieldRef(field.owner, check, params[0]); err != nil {
+			return nil, err
+		}
+		return c.afterTime, nil
+	})
+}
+
+func newTimeCheck(min, max int, factory func(c *timeCheck, field *Field, check *Check, params []string) (IsValid, error)) func(field *Field, check *Check) (IsValid, error) {
+	return func(field *Field, check *Check) (IsValid, error) {
+		params, layouts := splitTimeLayout(check.Parameters)
+		if err := expectParameters(&Check{Name: check.Name, Parameters: params}, min, max); err != nil {
+			return nil, err
+		}
+		if !isTimeField(field) {
+			return nil, NewUnsupportedTypeError(field, check)
+		}
+		return factory(&timeCheck{layouts: layouts}, field, check, params)
+	}
+}
+
+// splitTimeLayout separates layout=... parameters from other check parameters
+func splitTimeLayout(parameters []string) ([]string, []string) {
+	var params, layouts []string
+	for _, param := range parameters {
+		if strings.HasPrefix(param, layoutParameter) {
+			layouts = append(layouts, param[len(layoutParameter):])
+			continue
+		}
+		params = append(params, param)
+	}
+	return params, layouts
+}
+
+func newTimeExprParam(check *Check, literal string, layouts []string) (*timeExpr, error) {
+	ret, err := parseTimeExpr(literal, layouts)
+	if err != nil {
+		return nil, NewInvalidParameterError(check, literal, "expected time expression", err)
+	}
+	return ret, nil
+}
+
+// parseTimeExpr parses now, now+duration, now-duration, +duration, -duration or absolute time
+func parseTimeExpr(literal string, layouts []string) (*timeExpr, error) {
+	literal = strings.TrimSpace(literal)
+	if literal == "now" {
+		return &timeExpr{relative: true}, nil
+	}
+	offset := literal
+	if strings.HasPrefix(offset, "now") {
+		offset = offset[3:]
+	}
+	if strings.HasPrefix(offset, "+") || strings.HasPrefix(offset, "-") {
+		duration, err := parseRelativeDuration(offset)
+		if err != nil {
+			return nil, err
+		}
+		return &timeExpr{relative: true, offset: duration}, nil
+	}
+	at, ok := parseTime(literal, layouts)
+	if !ok && len(layouts) > 0 {
+		at, ok = parseTime(literal, nil)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unable to parse time: %q", literal)
+	}
+	return &timeExpr{at: at}, nil
+}
+
+// parseRelativeDuration parses signed time.Duration literal extended with d (day) and w (week) units, i.e. -30d, +1w2d
+func parseRelativeDuration(literal string) (time.Duration, error) {
+	sign := time.Duration(1)
+	text := literal
+	switch {
+	case strings.HasPrefix(text, "-"):
+		sign = -1
+		text = text[1:]
+	case strings.HasPrefix(text, "+"):
+		text = text[1:]
+	}
+	matches := relativeDurationRegex.FindAllStringSubmatch(text, -1)
+	matched := 0
+	var ret time.Duration
+	for _, match := range matches {
+		matched += len(match[0])
+		value, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return 0, err
+		}
+		switch match[2] {
+		case "d":
+			ret += time.Duration(value * float64(24*time.Hour))
+		case "w":
+			ret += time.Duration(value * float64(7*24*time.Hour))
+		default:
+			duration, err := time.ParseDuration(match[0])
+			if err != nil {
+				return 0, err
+			}
+			ret += duration
+		}
+	}
+	if len(matches) == 0 || matched != len(text) {
+		return 0, fmt.Errorf("invalid duration: %q", literal)
+	}
+	return sign * ret, nil
+}
+
+func parseWeekday(literal string) (time.Weekday, bool) {
+	literal = strings.ToLower(strings.TrimSpace(literal))
+	if len(literal) < 3 {
+		return 0, false
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.HasPrefix(strings.ToLower(day.String()), literal) {
+			return day, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,191 @@
+package govalidator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestService_Validate_TimeChecks(t *testing.T) {
+	now := time.Now()
+	monday := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	var testCases = []struct {
+		description  string
+		input        interface{}
+		expectFailed bool
+		expectErr    bool
+	}{
+		{
+			description: "after absolute date",
+			input: struct {
+				At time.Time `validate:"after(2020-01-01)"`
+			}{At: monday},
+		},
+		{
+			description: "after absolute date fails",
+			input: struct {
+				At string `validate:"after(2020-01-01)"`
+			}{At: "2019-12-31"},
+			expectFailed: true,
+		},
+		{
+			description: "before relative to now",
+			input: struct {
+				At *time.Time `validate:"before(now+24h)"`
+			}{At: &now},
+		},
+		{
+			description: "before relative to now fails",
+			input: struct {
+				At time.Time `validate:"before(now-1h)"`
+			}{At: now},
+			expectFailed: true,
+		},
+		{
+			description: "within relative range",
+			input: struct {
+				At time.Time `validate:"within(-30d,+1h)"`
+			}{At: now.Add(-10 * 24 * time.Hour)},
+		},
+		{
+			description: "within relative range fails",
+			input: struct {
+				At time.Time `validate:"within(-30d,+1h)"`
+			}{At: now.Add(-5 * 7 * 24 * time.Hour)},
+			expectFailed: true,
+		},
+		{
+			description: "custom layout",
+			input: struct {
+				At string `validate:"after(31/12/2023,layout=02/01/2006)"`
+			}{At: "01/01/2024"},
+		},
+		{
+			description: "custom layout does not match",
+			input: struct {
+				At string `validate:"after(2023-12-31,layout=02/01/2006)"`
+			}{At: "2024-01-01"},
+			expectFailed: true,
+		},
+		{
+			description: "past with custom layout",
+			input: struct {
+				At string `validate:"past(layout=20060102)"`
+			}{At: "20200101"},
+		},
+		{
+			description: "weekday",
+			input: struct {
+				At time.Time `validate:"weekday"`
+			}{At: monday},
+		},
+		{
+			description: "weekday listed days",
+			input: struct {
+				At []string `validate:"weekday(sat,sun)"`
+			}{At: []string{"2024-01-06", "2024-01-01"}},
+			expectFailed: true,
+		},
+		{
+			description: "aftertime",
+			input: struct {
+				Start time.Time
+				End   string `validate:"aftertime(Start)"`
+			}{Start: monday, End: "2024-01-02"},
+		},
+		{
+			description: "aftertime fails",
+			input: struct {
+				Start *time.Time
+				End   time.Time `validate:"aftertime(Start)"`
+			}{Start: &monday, End: monday},
+			expectFailed: true,
+		},
+		{
+			description: "aftertime empty other field",
+			input: struct {
+				Start *time.Time
+				End   time.Time `validate:"aftertime(Start)"`
+			}{End: monday},
+		},
+		{
+			description: "nil time pointer",
+			input: struct {
+				After   *time.Time `validate:"after(2020-01-01)"`
+				Before  *time.Time `validate:"before(now)"`
+				Within  *time.Time `validate:"within(-30d,+1h)"`
+				Weekday *time.Time `validate:"weekday"`
+			}{},
+			expectFailed: true,
+		},
+		{
+			description: "nil time pointer with omitempty",
+			input: struct {
+				After   *time.Time `validate:"omitempty,after(2020-01-01)"`
+				Before  *time.Time `validate:"omitempty,before(now)"`
+				Within  *time.Time `validate:"omitempty,within(-30d,+1h)"`
+				Weekday *time.Time `validate:"omitempty,weekday"`
+			}{},
+		},
+		{
+			description: "invalid time expression",
+			input: struct {
+				At time.Time `validate:"after(now+1x)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "invalid weekday",
+			input: struct {
+				At time.Time `validate:"weekday(funday)"`
+			}{},
+			expectErr: true,
+		},
+		{
+			description: "unsupported type",
+			input: struct {
+				At int `validate:"before(now)"`
+			}{},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		assert.EqualValues(t, testCase.expectFailed, validation.Failed, testCase.description)
+	}
+}
+
+func TestParseRelativeDuration(t *testing.T) {
+	var testCases = []struct {
+		literal   string
+		expect    time.Duration
+		expectErr bool
+	}{
+		{literal: "+1h", expect: time.Hour},
+		{literal: "-30d", expect: -30 * 24 * time.Hour},
+		{literal: "+1w2d", expect: 9 * 24 * time.Hour},
+		{literal: "+1h30m", expect: 90 * time.Minute},
+		{literal: "+1.5d", expect: 36 * time.Hour},
+		{literal: "+1x", expectErr: true},
+		{literal: "+", expectErr: true},
+	}
+	for _, testCase := range testCases {
+		actual, err := parseRelativeDuration(testCase.literal)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.literal)
+			continue
+		}
+		assert.Nil(t, err, testCase.literal)
+		assert.EqualValues(t, testCase.expect, actual, testCase.literal)
+	}
+}
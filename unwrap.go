@@ -0,0 +1,118 @@
+package govalidator
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+)
+
+var (
+	valuerType     = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+	stringType     = reflect.TypeOf("")
+)
+
+type (
+	//unwrapper represents wrapper type (sql.Null*, json.Number) to underlying value adapter
+	unwrapper struct {
+		Type   reflect.Type
+		number bool
+		unwrap func(value reflect.Value) (interface{}, bool, error)
+	}
+)
+
+// Unwrap returns underlying value and valid flag, invalid value is returned as underlying type zero value
+func (u *unwrapper) Unwrap(value interface{}) (interface{}, bool, error) {
+	rv, isNil := derefReflectValue(value)
+	if isNil {
+		return u.zero(), false, nil
+	}
+	ret, valid, err := u.unwrap(rv)
+	if err != nil || !valid {
+		return u.zero(), false, err
+	}
+	return ret, true, nil
+}
+
+func (u *unwrapper) zero() interface{} {
+	return reflect.Zero(u.Type).Interface()
+}
+
+// newUnwrapper returns unwrapper for sql.Null* like struct implementing driver.Valuer or json.Number, otherwise nil;
+// other driver.Valuer structs are not unwrapped, so checks of their own fields apply
+func newUnwrapper(t reflect.Type) *unwrapper {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == jsonNumberType {
+		return &unwrapper{Type: stringType, number: true, unwrap: func(value reflect.Value) (interface{}, bool, error) {
+			number := value.String()
+			return number, number != "", nil
+		}}
+	}
+	if t.Kind() != reflect.Struct || !(t.Implements(valuerType) || reflect.PtrTo(t).Implements(valuerType)) {
+		return nil
+	}
+	return newNullUnwrapper(t)
+}
+
+// newNullUnwrapper handles sql.NullString, sql.NullInt64, sql.NullTime, sql.Null[T] and alike, struct with value and Valid bool field
+func newNullUnwrapper(t reflect.Type) *unwrapper {
+	if t.NumField() != 2 {
+		return nil
+	}
+	validIndex := -1
+	for i := 0; i < 2; i++ {
+		if field := t.Field(i); field.Name == "Valid" && field.Type.Kind() == reflect.Bool {
+			validIndex = i
+		}
+	}
+	if validIndex == -1 {
+		return nil
+	}
+	valueIndex := 1 - validIndex
+	valueField := t.Field(valueIndex)
+	if valueField.PkgPath != "" {
+		return nil
+	}
+	return &unwrapper{Type: valueField.Type, unwrap: func(value reflect.Value) (interface{}, bool, error) {
+		if !value.Field(validIndex).Bool() {
+			return nil, false, nil
+		}
+		return value.Field(valueIndex).Interface(), true, nil
+	}}
+}
+
+// unwrapValue returns underlying value of wrapper type or value itself
+func unwrapValue(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	ret := newUnwrapper(reflect.TypeOf(value))
+	if ret == nil {
+		return value, nil
+	}
+	actual, valid, err := ret.Unwrap(value)
+	if err != nil || !valid {
+		return nil, err
+	}
+	return actual, nil
+}
+
+// isNumberString returns true if field holds json.Number unwrapped to string
+func (f *Field) isNumberString() bool {
+	return f.unwrapper != nil && f.unwrapper.number
+}
+
+// numberStringCheck adapts numeric check to json.Number value
+func numberStringCheck(isValid IsValid) IsValid {
+	return func(ctx context.Context, value interface{}) (bool, error) {
+		text, _ := asStringValue(value)
+		number, ok := parseDecimal(text)
+		if !ok {
+			return false, nil
+		}
+		return isValid(ctx, number)
+	}
+}
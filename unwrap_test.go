@@ -0,0 +1,146 @@
+package govalidator
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testMoney struct {
+	Amount   int64
+	Currency string `validate:"required,iso4217"`
+}
+
+func (m testMoney) Value() (driver.Value, error) {
+	return fmt.Sprintf("%v %v", m.Amount, m.Currency), nil
+}
+
+func TestService_Validate_Unwrap(t *testing.T) {
+	number := json.Number("12.5")
+	var testCases = []struct {
+		description  string
+		input        interface{}
+		expectFailed bool
+		expectPaths  []string
+	}{
+		{
+			description: "null string email",
+			input: struct {
+				Email sql.NullString `validate:"required,email"`
+			}{Email: sql.NullString{String: "dev@viantinc.com", Valid: true}},
+		},
+		{
+			description: "null string invalid email",
+			input: struct {
+				Email sql.NullString `validate:"email"`
+			}{Email: sql.NullString{String: "abc", Valid: true}},
+			expectFailed: true,
+			expectPaths:  []string{"Email"},
+		},
+		{
+			description: "null string not valid is required violation",
+			input: struct {
+				Name sql.NullString `validate:"required"`
+			}{Name: sql.NullString{String: "ignored"}},
+			expectFailed: true,
+			expectPaths:  []string{"Name"},
+		},
+		{
+			description: "null int not valid with omitempty",
+			input: struct {
+				Count sql.NullInt64 `validate:"omitempty,gt(10)"`
+			}{},
+		},
+		{
+			description: "null int gt",
+			input: struct {
+				Count *sql.NullInt64 `validate:"omitempty,gt(10)"`
+			}{Count: &sql.NullInt64{Int64: 5, Valid: true}},
+			expectFailed: true,
+			expectPaths:  []string{"Count"},
+		},
+		{
+			description: "null int choice",
+			input: struct {
+				Count sql.NullInt64 `validate:"choice(1,2,3)"`
+			}{Count: sql.NullInt64{Int64: 2, Valid: true}},
+		},
+		{
+			description: "null time past",
+			input: struct {
+				At sql.NullTime `validate:"omitempty,past"`
+			}{At: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true}},
+			expectFailed: true,
+			expectPaths:  []string{"At"},
+		},
+		{
+			description: "json number gt",
+			input: struct {
+				Price json.Number `validate:"required,gt(10),multipleof(0.5)"`
+			}{Price: "12.5"},
+		},
+		{
+			description: "json number pointer max",
+			input: struct {
+				Price *json.Number `validate:"max(10)"`
+			}{Price: &number},
+			expectFailed: true,
+			expectPaths:  []string{"Price"},
+		},
+		{
+			description: "json number empty omitempty",
+			input: struct {
+				Price json.Number `validate:"omitempty,between(1,5)"`
+			}{},
+		},
+		{
+			description: "json number choice",
+			input: struct {
+				Kind json.Number `validate:"choice(1,2)"`
+			}{Kind: "3"},
+			expectFailed: true,
+			expectPaths:  []string{"Kind"},
+		},
+		{
+			description: "driver valuer struct fields are checked",
+			input: struct {
+				Price testMoney
+			}{Price: testMoney{Amount: 99, Currency: "bogus"}},
+			expectFailed: true,
+			expectPaths:  []string{"Price.Currency"},
+		},
+		{
+			description: "cross field unwraps other field",
+			input: struct {
+				Password sql.NullString
+				Confirm  string `validate:"eqfield(Password)"`
+			}{Password: sql.NullString{String: "secret", Valid: true}, Confirm: "secret"},
+		},
+		{
+			description: "required with not valid other field",
+			input: struct {
+				Phone sql.NullString
+				Code  string `validate:"required_with(Phone)"`
+			}{Phone: sql.NullString{String: "123"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		validation, err := New().Validate(context.Background(), testCase.input)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		assert.EqualValues(t, testCase.expectFailed, validation.Failed, testCase.description)
+		var paths []string
+		for _, violation := range validation.Violations {
+			paths = append(paths, violation.Location)
+		}
+		assert.EqualValues(t, testCase.expectPaths, paths, testCase.description)
+	}
+}
@@ -8,13 +8,29 @@ import (
 	"unsafe"
 )
 
+//RedactedValue represents violation value and $value replacement for sensitive fields
+const RedactedValue = "[REDACTED]"
+
+const (
+	//SeverityError defines error severity, only errors fail validation
+	SeverityError = Severity("error")
+	//SeverityWarning defines warning severity
+	SeverityWarning = Severity("warning")
+	//SeverityInfo defines info severity
+	SeverityInfo = Severity("info")
+)
+
 type (
+	//Severity represents violation severity
+	Severity string
+
 	Violation struct {
 		Location string
 		Field    string
 		Value    interface{}
 		Message  string
 		Check    string
+		Severity Severity
 	}
 
 	Validation struct {
@@ -23,6 +39,20 @@ type (
 	}
 )
 
+//IsError returns true for error severity, empty severity is treated as error
+func (s Severity) IsError() bool {
+	return s == "" || s == SeverityError
+}
+
+//IsValid returns true for supported severity
+func (s Severity) IsValid() bool {
+	switch s {
+	case "", SeverityError, SeverityWarning, SeverityInfo:
+		return true
+	}
+	return false
+}
+
 func (v *Validation) AddViolation(field string, value interface{}, check string, msg string) {
 	path := &Path{Kind: PathKinField, Name: field}
 	v.Append(path, field, value, check, msg, nil)
@@ -30,18 +60,114 @@ func (v *Validation) AddViolation(field string, value interface{}, check string,
 
 func (v *Validation) Append(path *Path, field string, value interface{}, check string, msg string, params []string) {
 	value = derefIfNeeded(value)
-	param := strings.Join(params, ",")
-	otherField := inferOtherField(check, params)
-	if msg == "" {
-		msg = fmt.Sprintf("check '%v' failed on field %v", check, field)
+	msg = expandMessage(field, value, check, msg, params)
+	v.appendViolation(path, field, value, check, msg, SeverityError)
+}
+
+//AppendCheck appends violation for failed check, message template takes precedence over check message
+func (v *Validation) AppendCheck(path *Path, field string, value interface{}, check *Check, msg *MessageTemplate) error {
+	value = derefIfNeeded(value)
+	params := messageParams(value, check.Parameters)
+	text := ""
+	if msg != nil {
+		var err error
+		if text, err = msg.Render(newMessageData(path, field, value, check.Name, params)); err != nil {
+			return err
+		}
 	} else {
-		replacer := strings.NewReplacer(
-			"$field", field,
-			"$value", fmt.Sprintf("%v", value),
-			"$param", param,
-			"$otherField", otherField,
-		)
-		msg = replacer.Replace(msg)
+		text = expandMessage(field, value, check.Name, check.Message, params)
+	}
+	v.appendViolation(path, field, value, check.Name, text, check.Severity)
+	return nil
+}
+
+//HasErrors returns true if any violation has error severity
+func (v *Validation) HasErrors() bool {
+	for _, violation := range v.Violations {
+		if violation.Severity.IsError() {
+			return true
+		}
+	}
+	return false
+}
+
+//BySeverity returns violations with supplied severity
+func (v *Validation) BySeverity(severity Severity) []*Violation {
+	var result []*Violation
+	for _, violation := range v.Violations {
+		if violation.Severity == severity || (severity.IsError() && violation.Severity.IsError()) {
+			result = append(result, violation)
+		}
+	}
+	return result
+}
+
+//Filter returns validation with copies of violations matching predicate, so Prefix on result does not change the original
+func (v *Validation) Filter(predicate func(violation *Violation) bool) *Validation {
+	ret := &Validation{}
+	if v == nil {
+		return ret
+	}
+	for _, violation := range v.Violations {
+		if predicate(violation) {
+			copied := *violation
+			ret.Violations = append(ret.Violations, &copied)
+		}
+	}
+	ret.Failed = ret.HasErrors()
+	return ret
+}
+
+//ByPath returns validation with violations located at or under supplied path, i.e. Items matches Items[1].Name
+func (v *Validation) ByPath(prefix string) *Validation {
+	return v.Filter(func(violation *Violation) bool {
+		return isUnderLocation(violation.Location, prefix)
+	})
+}
+
+//ByCheck returns validation with violations of supplied check
+func (v *Validation) ByCheck(name string) *Validation {
+	return v.Filter(func(violation *Violation) bool {
+		return strings.EqualFold(violation.Check, name)
+	})
+}
+
+//Merge appends copies of other validation violations, so Prefix on result does not change other; nil receiver returns new validation
+func (v *Validation) Merge(other *Validation) *Validation {
+	if v == nil {
+		v = &Validation{}
+	}
+	if other != nil {
+		for _, violation := range other.Violations {
+			copied := *violation
+			v.Violations = append(v.Violations, &copied)
+		}
+	}
+	v.Failed = v.HasErrors()
+	return v
+}
+
+//Prefix re-roots violations location under supplied path, i.e. Body with Items[0].Name gives Body.Items[0].Name
+func (v *Validation) Prefix(path string) *Validation {
+	if path == "" {
+		return v
+	}
+	for _, violation := range v.Violations {
+		switch {
+		case violation.Location == "":
+			violation.Location = path
+		case violation.Location[0] == '[':
+			violation.Location = path + violation.Location
+		default:
+			violation.Location = path + "." + violation.Location
+		}
+	}
+	return v
+}
+
+func (v *Validation) appendViolation(path *Path, field string, value interface{}, check string, msg string, severity Severity) {
+	if severity == "" {
+		severity = SeverityError
 	}
 	v.Violations = append(v.Violations, &Violation{
 		Location: path.String(),
@@ -49,8 +175,22 @@ func (v *Validation) Append(path *Path, field string, value interface{}, check s
 		Message:  msg,
 		Check:    check,
 		Value:    value,
+		Severity: severity,
 	})
-	v.Failed = len(v.Violations) > 0
+	v.Failed = v.Failed || severity.IsError()
+}
+
+func expandMessage(field string, value interface{}, check string, msg string, params []string) string {
+	if msg == "" {
+		return fmt.Sprintf("check '%v' failed on field %v", check, field)
+	}
+	replacer := strings.NewReplacer(
+		"$field", field,
+		"$value", fmt.Sprintf("%v", value),
+		"$param", strings.Join(params, ","),
+		"$otherField", inferOtherField(check, params),
+	)
+	return replacer.Replace(msg)
 }
 
 func (e *Validation) Error() string {
@@ -106,10 +246,25 @@ func derefIfNeeded(value interface{}) interface{} {
 
 func inferOtherField(check string, params []string) string {
 	switch strings.ToLower(check) {
-	case "eqfield", "nefield", "gtfield", "required_if", "required_unless", "required_with", "required_without":
+	case "eqfield", "nefield", "gtfield", "gefield", "ltfield", "lefield", "aftertime", "required_if", "required_unless", "required_with", "required_without",
+		"required_with_all", "required_without_all", "excluded_if", "excluded_unless", "excluded_with":
 		if len(params) > 0 {
 			return params[0]
 		}
 	}
 	return ""
 }
+
+func isUnderLocation(location, prefix string) bool {
+	if prefix == "" || location == prefix {
+		return true
+	}
+	if !strings.HasPrefix(location, prefix) {
+		return false
+	}
+	switch location[len(prefix)] {
+	case '.', '[':
+		return true
+	}
+	return false
+}
@@ -14,3 +14,118 @@ func TestValidation_AppendTemplateVariables(t *testing.T) {
 		assert.Equal(t, "Confirm:y:Password:Password", validation.Violations[0].Message)
 	}
 }
+
+func TestValidation_AppendCheckTemplate(t *testing.T) {
+	var testCases = []struct {
+		description string
+		template    string
+		value       interface{}
+		params      []string
+		expect      string
+		expectErr   bool
+	}{
+		{
+			description: "field and indexed param",
+			template:    "{{.Field}} must be at least {{index .Params 0}} {{plural (index .Params 0) \"character\" \"characters\"}}",
+			value:       "ab",
+			params:      []string{"3"},
+			expect:      "Name must be at least 3 characters",
+		},
+		{
+			description: "singular",
+			template:    "{{.Field}} must be at least {{index .Params 0}} {{plural (index .Params 0) \"character\" \"characters\"}}",
+			value:       "",
+			params:      []string{"1"},
+			expect:      "Name must be at least 1 character",
+		},
+		{
+			description: "formatted value",
+			template:    "{{printf \"%.2f\" .Value}} exceeds {{.Param}}",
+			value:       12.3456,
+			params:      []string{"10"},
+			expect:      "12.35 exceeds 10",
+		},
+		{
+			description: "missing param",
+			template:    "{{index .Params 1}}",
+			value:       1,
+			params:      []string{"10"},
+			expectErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		message, err := NewMessageTemplate("min", testCase.template)
+		if !assert.Nil(t, err, testCase.description) {
+			continue
+		}
+		validation := &Validation{}
+		err = validation.AppendCheck(NewPath().Field("Name"), "Name", testCase.value, &Check{Name: "min", Parameters: testCase.params}, message)
+		if testCase.expectErr {
+			assert.NotNil(t, err, testCase.description)
+			continue
+		}
+		if assert.Nil(t, err, testCase.description) && assert.Equal(t, 1, len(validation.Violations), testCase.description) {
+			assert.Equal(t, testCase.expect, validation.Violations[0].Message, testCase.description)
+		}
+	}
+}
+
+func TestRegisterMessageFunc(t *testing.T) {
+	RegisterMessageFunc("shout", func(text string) string { return text + "!" })
+	message, err := NewMessageTemplate("required", "{{shout .Field}}")
+	if !assert.Nil(t, err) {
+		return
+	}
+	validation := &Validation{}
+	assert.Nil(t, validation.AppendCheck(NewPath().Field("Name"), "Name", "", &Check{Name: "required"}, message))
+	assert.Equal(t, "Name!", validation.Violations[0].Message)
+}
+
+func TestValidation_Query(t *testing.T) {
+	body := &Validation{}
+	body.Append(NewPath().Field("Items").Element(0).Field("Name"), "Name", "", "required", "", nil)
+	body.Append(NewPath().Field("Items").Element(1).Field("ID"), "ID", 0, "gt", "", []string{"0"})
+	body.Append(NewPath().Field("ItemsCount"), "ItemsCount", 0, "required", "", nil)
+	body.Append(NewPath().Element(2), "", "x", "max", "", nil)
+
+	items := body.ByPath("Items")
+	if assert.Equal(t, 2, len(items.Violations)) {
+		assert.True(t, items.Failed)
+	}
+	assert.Equal(t, 1, len(body.ByPath("Items[1]").Violations))
+	assert.Equal(t, 4, len(body.ByPath("").Violations))
+	assert.Equal(t, 2, len(body.ByCheck("Required").Violations))
+	empty := body.Filter(func(violation *Violation) bool { return false })
+	assert.False(t, empty.Failed)
+	assert.Equal(t, 0, len(empty.Violations))
+	assert.Equal(t, "Body.ItemsCount", body.ByCheck("required").Prefix("Body").Violations[1].Location)
+	assert.Equal(t, "ItemsCount", body.Violations[2].Location)
+
+	header := &Validation{}
+	header.Append(NewPath().Field("Token"), "Token", "", "required", "", nil)
+	header.Prefix("Header").Merge(body.Prefix("Body"))
+	assert.True(t, header.Failed)
+	var locations []string
+	for _, violation := range header.Violations {
+		locations = append(locations, violation.Location)
+	}
+	assert.Equal(t, []string{"Header.Token", "Body.Items[0].Name", "Body.Items[1].ID", "Body.ItemsCount", "Body[2]"}, locations)
+
+	warnings := &Validation{}
+	warnings.Merge(header.Filter(func(violation *Violation) bool { return violation.Check == "max" }))
+	assert.True(t, warnings.Failed)
+	warnings.Violations[0].Severity = SeverityWarning
+	assert.False(t, warnings.Merge(nil).Failed)
+
+	source := &Validation{}
+	source.Append(NewPath().Field("A"), "A", "", "required", "", nil)
+	merged := (&Validation{}).Merge(source).Prefix("X")
+	assert.Equal(t, "X.A", merged.Violations[0].Location)
+	assert.Equal(t, "A", source.Violations[0].Location)
+	var none *Validation
+	if merged = none.Merge(source); assert.NotNil(t, merged) {
+		assert.True(t, merged.Failed)
+		assert.Equal(t, 1, len(merged.Violations))
+	}
+}